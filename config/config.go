@@ -0,0 +1,279 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/YangTaeyoung/gombok/generate"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName 은 설정 파일의 이름입니다.
+const FileName = ".gombok.yaml"
+
+// DefaultSuffix 는 설정 파일에서 지정하지 않았을 때 생성된 파일 이름에 붙는 접미사입니다.
+const DefaultSuffix = "_gombok.go"
+
+// PackageFileName 은 output 이 package 일 때 패키지의 모든 코드를 작성하는 파일의 이름입니다.
+const PackageFileName = "zz_generated.gombok.go"
+
+// 생성된 코드를 작성하는 단위입니다. OutputFile 은 소스 파일마다 <file>_gombok.go 를, OutputPackage 는 패키지마다 zz_generated.gombok.go 를 만듭니다.
+const (
+	OutputFile    = "file"
+	OutputPackage = "package"
+)
+
+// getter 이름 방식입니다. GetterGet 은 GetName, GetterPlain 은 Name 과 같이 getter 를 만듭니다.
+const (
+	GetterGet   = "get"
+	GetterPlain = "plain"
+)
+
+// file 은 설정 파일의 내용입니다.
+type file struct {
+	Suffix      string                    `yaml:"suffix"`
+	Output      string                    `yaml:"output"`
+	Header      string                    `yaml:"header"`
+	Exclude     []string                  `yaml:"exclude"`
+	GetterStyle string                    `yaml:"getterStyle"`
+	Templates   string                    `yaml:"templates"`
+	Plugins     string                    `yaml:"plugins"`
+	Annotations map[string]map[string]any `yaml:"annotations"`
+}
+
+// Exclude 는 설정 파일에 작성된 제외 패턴입니다. 패턴은 설정 파일이 있는 디렉토리 Dir 기준의 상대 경로와 비교합니다.
+type Exclude struct {
+	Dir      string
+	Patterns []string
+}
+
+// Config 는 디렉토리에 적용되는 설정입니다.
+// 상위 디렉토리의 설정 파일부터 차례로 합치며, 하위 디렉토리의 설정이 상위 디렉토리의 설정을 덮어씁니다.
+type Config struct {
+	// Suffix 는 생성된 파일 이름에 붙는 접미사입니다. 예) user.go -> user_gombok.go
+	Suffix string
+	// Output 은 생성된 코드를 작성하는 단위입니다.
+	Output string
+	// Header 는 생성된 파일의 "Code generated" 주석 아래에 작성할 주석입니다.
+	Header string
+	// GetterStyle 은 getter 이름 방식입니다.
+	GetterStyle string
+	// Annotations 는 어노테이션별 기본 인자입니다. 어노테이션에 작성한 인자가 우선합니다.
+	Annotations map[string]generate.Arguments
+	// Templates 는 기본 템플릿 대신 사용할 템플릿입니다. 하위 디렉토리의 템플릿 디렉토리에 있는 템플릿만 덮어씁니다.
+	Templates generate.Templates
+	// Plugins 는 어노테이션 이름별로 설정 파일에서 정의한 어노테이션입니다. 하위 디렉토리의 플러그인은 이름이 같은 플러그인만 덮어씁니다.
+	Plugins map[string]generate.Plugin
+	// Excludes 는 상위 디렉토리부터 차례로 모은 제외 패턴입니다.
+	Excludes []Exclude
+}
+
+// Default 는 설정 파일이 없을 때의 설정입니다.
+func Default() *Config {
+	return &Config{
+		Suffix:      DefaultSuffix,
+		Output:      OutputFile,
+		GetterStyle: GetterGet,
+		Annotations: make(map[string]generate.Arguments),
+		Plugins:     make(map[string]generate.Plugin),
+	}
+}
+
+// Arguments 는 어노테이션의 기본 인자를 반환합니다.
+func (c *Config) Arguments(annotation string) generate.Arguments {
+	args := make(generate.Arguments)
+	if annotation == "Getter" && c.GetterStyle == GetterPlain {
+		args["prefix"] = ""
+	}
+
+	for key, value := range c.Annotations[annotation] {
+		args[key] = value
+	}
+
+	return args
+}
+
+// merge 는 설정 파일의 내용을 설정에 덮어씁니다.
+// templates 와 plugins 는 설정 파일의 템플릿 디렉토리와 plugins 디렉토리에서 읽은 템플릿과 플러그인입니다.
+func (c *Config) merge(dir string, f file, templates generate.Templates, plugins map[string]generate.Plugin) *Config {
+	merged := &Config{
+		Suffix:      c.Suffix,
+		Output:      c.Output,
+		Header:      c.Header,
+		GetterStyle: c.GetterStyle,
+		Annotations: make(map[string]generate.Arguments),
+		Templates:   make(generate.Templates),
+		Plugins:     make(map[string]generate.Plugin),
+		Excludes:    append([]Exclude{}, c.Excludes...),
+	}
+
+	for name, text := range c.Templates {
+		merged.Templates[name] = text
+	}
+	for name, text := range templates {
+		merged.Templates[name] = text
+	}
+	for name, plugin := range c.Plugins {
+		merged.Plugins[name] = plugin
+	}
+	for name, plugin := range plugins {
+		merged.Plugins[name] = plugin
+	}
+
+	if f.Suffix != "" {
+		merged.Suffix = f.Suffix
+	}
+	if f.Output != "" {
+		merged.Output = f.Output
+	}
+	if f.Header != "" {
+		merged.Header = f.Header
+	}
+	if f.GetterStyle != "" {
+		merged.GetterStyle = f.GetterStyle
+	}
+	if len(f.Exclude) > 0 {
+		merged.Excludes = append(merged.Excludes, Exclude{Dir: dir, Patterns: f.Exclude})
+	}
+
+	for annotation, args := range c.Annotations {
+		merged.Annotations[annotation] = make(generate.Arguments)
+		for key, value := range args {
+			merged.Annotations[annotation][key] = value
+		}
+	}
+	for annotation, args := range f.Annotations {
+		if merged.Annotations[annotation] == nil {
+			merged.Annotations[annotation] = make(generate.Arguments)
+		}
+		for key, value := range args {
+			merged.Annotations[annotation][key] = argumentValue(value)
+		}
+	}
+
+	return merged
+}
+
+// argumentValue 는 YAML 값을 어노테이션 인자의 값으로 바꿉니다. 목록은 []string, 숫자는 문자열이 됩니다.
+func argumentValue(value any) any {
+	switch v := value.(type) {
+	case string, bool:
+		return v
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			list = append(list, fmt.Sprint(item))
+		}
+		return list
+	}
+
+	return fmt.Sprint(value)
+}
+
+// validate 는 설정 파일의 값을 확인합니다.
+func (f file) validate() error {
+	if f.Suffix != "" && (!strings.HasSuffix(f.Suffix, ".go") || strings.HasSuffix(f.Suffix, "_test.go")) {
+		return fmt.Errorf("suffix must end with .go and must not end with _test.go, got %q", f.Suffix)
+	}
+
+	if f.Output != "" && f.Output != OutputFile && f.Output != OutputPackage {
+		return fmt.Errorf("output must be %s or %s, got %q", OutputFile, OutputPackage, f.Output)
+	}
+
+	if f.GetterStyle != "" && f.GetterStyle != GetterGet && f.GetterStyle != GetterPlain {
+		return fmt.Errorf("getterStyle must be %s or %s, got %q", GetterGet, GetterPlain, f.GetterStyle)
+	}
+
+	return nil
+}
+
+// Loader 는 디렉토리별 설정을 읽습니다. 같은 디렉토리의 설정은 한 번만 읽습니다.
+type Loader struct {
+	configs map[string]*Config
+}
+
+// NewLoader 는 Loader 를 만듭니다.
+func NewLoader() *Loader {
+	return &Loader{configs: make(map[string]*Config)}
+}
+
+// Load 는 디렉토리에 적용되는 설정을 읽습니다.
+// 디렉토리부터 go.mod 가 있는 모듈 루트까지 올라가며 설정 파일을 찾고, 설정 파일이 없다면 기본 설정을 사용합니다.
+func (l *Loader) Load(dir string) (*Config, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if config, ok := l.configs[dir]; ok {
+		return config, nil
+	}
+
+	parent := Default()
+	if !isModuleRoot(dir) && filepath.Dir(dir) != dir {
+		parent, err = l.Load(filepath.Dir(dir))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config := parent
+	content, err := os.ReadFile(filepath.Join(dir, FileName))
+	switch {
+	case err == nil:
+		// 오타를 찾기 위해 알 수 없는 키는 오류로 처리합니다.
+		var f file
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		if err = decoder.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("parsing %s: %w", filepath.Join(dir, FileName), err)
+		}
+		if err = f.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Join(dir, FileName), err)
+		}
+
+		var templates generate.Templates
+		if f.Templates != "" {
+			templates, err = generate.LoadTemplates(resolve(dir, f.Templates))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filepath.Join(dir, FileName), err)
+			}
+		}
+
+		var plugins map[string]generate.Plugin
+		if f.Plugins != "" {
+			plugins, err = loadPlugins(resolve(dir, f.Plugins))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filepath.Join(dir, FileName), err)
+			}
+		}
+
+		config = parent.merge(dir, f, templates, plugins)
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("reading %s: %w", filepath.Join(dir, FileName), err)
+	}
+
+	l.configs[dir] = config
+	return config, nil
+}
+
+// resolve 는 설정 파일에 작성된 경로를 절대 경로로 바꿉니다. 상대 경로는 설정 파일이 있는 디렉토리 기준입니다.
+func resolve(dir string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+// isModuleRoot 는 디렉토리에 go.mod 가 있는지 확인합니다.
+func isModuleRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "go.mod"))
+	return err == nil
+}
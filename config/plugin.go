@@ -0,0 +1,104 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/YangTaeyoung/gombok/generate"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestExt 는 plugins 디렉토리에서 읽는 manifest 파일의 확장자입니다.
+const ManifestExt = ".yaml"
+
+// manifest 는 어노테이션 플러그인을 정의하는 파일의 내용입니다.
+type manifest struct {
+	// Annotation 은 @ 를 제외한 어노테이션 이름입니다.
+	Annotation string `yaml:"annotation"`
+	// Template 은 manifest 기준의 템플릿 파일 경로입니다. 지정하지 않으면 manifest 와 이름이 같은 .tmpl 파일입니다.
+	Template string `yaml:"template"`
+	// Arguments 는 인자 이름별 종류(string, bool, list)입니다.
+	Arguments map[string]string `yaml:"arguments"`
+	Tags      []string          `yaml:"tags"`
+	Imports   []string          `yaml:"imports"`
+}
+
+// loadPlugins 는 디렉토리의 manifest 파일을 읽어 어노테이션 이름별 플러그인을 반환합니다.
+func loadPlugins(dir string) (map[string]generate.Plugin, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading plugins: %w", err)
+	}
+
+	plugins := make(map[string]generate.Plugin)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ManifestExt {
+			continue
+		}
+
+		plugin, err := loadPlugin(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", entry.Name(), err)
+		}
+
+		if _, exists := plugins[plugin.Annotation]; exists {
+			return nil, fmt.Errorf("plugin %s: @%s is defined more than once", entry.Name(), plugin.Annotation)
+		}
+		plugins[plugin.Annotation] = plugin
+	}
+
+	return plugins, nil
+}
+
+// loadPlugin 은 manifest 파일과 manifest 가 가리키는 템플릿을 읽습니다.
+func loadPlugin(path string) (generate.Plugin, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return generate.Plugin{}, err
+	}
+
+	var m manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&m); err != nil && !errors.Is(err, io.EOF) {
+		return generate.Plugin{}, err
+	}
+
+	// 오류 메시지가 항상 같도록 인자는 이름 순서로 확인합니다.
+	names := make([]string, 0, len(m.Arguments))
+	for name := range m.Arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	arguments := make(map[string]generate.ArgumentKind)
+	for _, name := range names {
+		kind, err := generate.ParseArgumentKind(m.Arguments[name])
+		if err != nil {
+			return generate.Plugin{}, fmt.Errorf("argument %s: %w", name, err)
+		}
+		arguments[name] = kind
+	}
+
+	templatePath := m.Template
+	if templatePath == "" {
+		templatePath = strings.TrimSuffix(filepath.Base(path), ManifestExt) + generate.TemplateExt
+	}
+	if !filepath.IsAbs(templatePath) {
+		templatePath = filepath.Join(filepath.Dir(path), templatePath)
+	}
+
+	text, err := os.ReadFile(templatePath)
+	if err != nil {
+		return generate.Plugin{}, fmt.Errorf("reading template: %w", err)
+	}
+
+	return generate.NewPlugin(m.Annotation, arguments, m.Tags, m.Imports, string(text))
+}
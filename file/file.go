@@ -2,19 +2,38 @@ package file
 
 import (
 	"bytes"
-	"log"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"os"
-	"os/exec"
+	"path"
+	"strings"
 	"text/template"
 )
 
+// generatedHeader 는 gombok 이 생성한 파일의 첫 줄입니다. 생성된 파일을 찾을 때 사용합니다.
+const generatedHeader = "// Code generated by gombok"
+
 var fileTemplate = `// Code generated by gombok. DO NOT EDIT.
+{{- with .Header}}
+{{.}}
+{{- end}}
 package {{.PackageName}}
 
-{{- if len .ImportPackages }}
+{{- if eq (len .ImportPackages) 1 }}
+{{with index .ImportPackages 0}}
+import {{.Alias}} "{{.Path}}"
+{{- end}}
+{{- else if .ImportPackages }}
 import (
-{{- range .ImportPackages}}	
-{{.Alias}} "{{.Path}}"
+{{- range $i, $group := .ImportGroups}}
+{{- if $i}}
+{{end}}
+{{- range $group}}
+	{{.Alias}} "{{.Path}}"
+{{- end}}
 {{- end}}
 )
 {{- end}}
@@ -25,51 +44,174 @@ import (
 type ImportPackage struct {
 	Alias string
 	Path  string
+	// Name 은 패키지 이름입니다. 비어 있다면 경로의 마지막 요소를 사용합니다.
+	Name string
+}
+
+// Qualifier 는 생성된 코드에서 패키지를 가리키는 이름을 반환합니다.
+func (i ImportPackage) Qualifier() string {
+	if i.Alias != "" {
+		return i.Alias
+	}
+	if i.Name != "" {
+		return i.Name
+	}
+
+	return path.Base(i.Path)
+}
+
+// standardImports 는 생성된 코드에서 사용하는 표준 라이브러리 패키지입니다.
+var standardImports = []ImportPackage{
+	{Path: "fmt", Name: "fmt"},
+	{Path: "hash/fnv", Name: "fnv"},
+	{Path: "reflect", Name: "reflect"},
 }
 
 type TemplateElement struct {
-	PackageName    string
+	PackageName string
+	// Header 는 "Code generated" 주석 아래에 작성할 주석입니다.
+	Header         string
 	ImportPackages []ImportPackage
 	Content        string
 }
 
-func WriteFile(packageName string, importPackages []ImportPackage, content string, filepath string) error {
+// ImportGroups 는 goimports 와 같이 표준 라이브러리 패키지와 나머지 패키지를 나눕니다.
+func (t TemplateElement) ImportGroups() [][]ImportPackage {
+	var standard, others []ImportPackage
+	for _, pkg := range t.ImportPackages {
+		if strings.Contains(strings.Split(pkg.Path, "/")[0], ".") {
+			others = append(others, pkg)
+		} else {
+			standard = append(standard, pkg)
+		}
+	}
+
+	groups := make([][]ImportPackage, 0, 2)
+	for _, group := range [][]ImportPackage{standard, others} {
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// Render 는 생성된 코드로 파일 내용을 만들고 포맷합니다. 파일은 작성하지 않습니다.
+// importPackages 와 생성된 코드에서 사용하는 표준 라이브러리 패키지 중 생성된 코드가 실제로 참조하는 패키지만 import 합니다.
+// filepath 는 오류 메시지에 사용됩니다.
+func Render(packageName string, header string, importPackages []ImportPackage, content string, filepath string) ([]byte, error) {
 	tmpl, err := template.New("file").Parse(fileTemplate)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// import 없이 먼저 생성된 코드를 읽어 참조하는 패키지를 찾습니다.
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, TemplateElement{PackageName: packageName, Header: commentLines(header), Content: content})
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), filepath, buf.Bytes(), 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing generated code: %w", err)
 	}
 
 	data := TemplateElement{
 		PackageName:    packageName,
-		ImportPackages: importPackages,
+		Header:         commentLines(header),
+		ImportPackages: usedImports(file, append(importPackages, standardImports...)),
 		Content:        content,
 	}
 
-	var buf bytes.Buffer
-
+	buf.Reset()
 	err = tmpl.Execute(&buf, data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = os.WriteFile(filepath, buf.Bytes(), 0644)
+	formatted, err := format.Source(buf.Bytes())
 	if err != nil {
-		log.Printf("Error writing file %s: %v", filepath, err)
-		return err
+		return nil, fmt.Errorf("formatting generated code: %w", err)
 	}
 
-	cmd := exec.Command("goimports", "-w", filepath)
-	if err = cmd.Run(); err != nil {
-		log.Printf("Error formatting file %s: %v", filepath, err)
+	return formatted, nil
+}
 
-		if err = os.Remove(filepath); err != nil {
-			log.Printf("Error removing file %s: %v", filepath, err)
-			return err
+// UsedImports 는 importPackages 와 생성된 코드에서 사용하는 표준 라이브러리 패키지 중 content 가 참조하는 패키지를 반환합니다.
+// content 는 package 절이 없는 생성된 코드입니다.
+func UsedImports(content string, importPackages []ImportPackage) ([]ImportPackage, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package p\n\n"+content, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing generated code: %w", err)
+	}
+
+	candidates := append(append([]ImportPackage{}, importPackages...), standardImports...)
+	return usedImports(file, candidates), nil
+}
+
+// usedImports 는 후보 패키지 중 생성된 코드에서 패키지 이름으로 참조하는 패키지를 반환합니다.
+// 같은 이름의 패키지가 여러 개라면 먼저 지정된 패키지를 사용하며, _ 와 . 으로 import 된 패키지는 사용하지 않습니다.
+// file 은 객체 해석과 함께 파싱되어야 합니다. 선언된 이름으로 해석되지 않는 selector 만 패키지를 참조합니다.
+func usedImports(file *ast.File, candidates []ImportPackage) []ImportPackage {
+	// 매개변수나 지역 변수가 패키지와 이름이 같더라도 해당 변수의 범위 밖에서는 패키지를 참조할 수 있으므로
+	// 이름이 아닌 각 식별자가 선언으로 해석되었는지로 판단합니다. 예) func (e *Event) SetTime(time time.Time)
+	referenced := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if selector, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil {
+				referenced[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	used := make([]ImportPackage, 0)
+	for _, candidate := range candidates {
+		qualifier := candidate.Qualifier()
+		if qualifier == "_" || qualifier == "." || !referenced[qualifier] {
+			continue
 		}
 
-		log.Printf("File %s removed", filepath)
-		return err
+		used = append(used, candidate)
+		delete(referenced, qualifier)
+	}
+
+	return used
+}
+
+// IsGenerated 는 파일이 gombok 이 생성한 파일인지 첫 줄의 주석으로 확인합니다.
+func IsGenerated(path string) (bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.HasPrefix(content, []byte(generatedHeader)), nil
+}
+
+// HasGeneratedHeader 는 파싱된 파일이 gombok 이 생성한 파일인지 확인합니다.
+func HasGeneratedHeader(file *ast.File) bool {
+	if len(file.Comments) == 0 || file.Comments[0].Pos() > file.Package {
+		return false
+	}
+
+	return strings.HasPrefix(file.Comments[0].List[0].Text, generatedHeader)
+}
+
+// commentLines 는 설정 파일의 header 를 주석으로 바꿉니다. 이미 // 로 시작하는 줄은 그대로 사용합니다.
+func commentLines(header string) string {
+	header = strings.TrimRight(header, "\n")
+	if header == "" {
+		return ""
+	}
+
+	lines := strings.Split(header, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "//") {
+			lines[i] = strings.TrimRight("// "+line, " ")
+		}
 	}
 
-	return nil
+	return strings.Join(lines, "\n")
 }
@@ -0,0 +1,74 @@
+package file
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderImports(t *testing.T) {
+	tests := []struct {
+		name    string
+		imports []ImportPackage
+		content string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "parameter shadows package in its own signature",
+			imports: []ImportPackage{{Path: "time", Name: "time"}},
+			content: "type Event struct{ Time time.Time }\n\nfunc (e *Event) SetTime(time time.Time) {\n\te.Time = time\n}\n",
+			want:    []string{`import "time"`},
+		},
+		{
+			name:    "parameter shadows package in another function",
+			imports: []ImportPackage{{Path: "time", Name: "time"}},
+			content: "func A(time int) int {\n\treturn time\n}\n\nfunc B() time.Duration {\n\treturn time.Second\n}\n",
+			want:    []string{`import "time"`},
+		},
+		{
+			name:    "local variable is not a package",
+			imports: []ImportPackage{{Path: "strings", Name: "strings"}},
+			content: "type S struct{ Len int }\n\nfunc F() int {\n\tstrings := S{}\n\treturn strings.Len\n}\n",
+			notWant: []string{`"strings"`},
+		},
+		{
+			name:    "unused source imports are dropped",
+			imports: []ImportPackage{{Path: "io", Name: "io"}},
+			content: "func F() string {\n\treturn fmt.Sprint(1)\n}\n",
+			want:    []string{`import "fmt"`},
+			notWant: []string{`"io"`},
+		},
+		{
+			name:    "alias is kept",
+			imports: []ImportPackage{{Alias: "goast", Path: "go/ast", Name: "ast"}},
+			content: "func F(n goast.Node) {}\n",
+			want:    []string{`import goast "go/ast"`},
+		},
+		{
+			name:    "package name differs from path",
+			imports: []ImportPackage{{Path: "example.com/go-yaml", Name: "yaml"}},
+			content: "func F() yaml.Node {\n\treturn yaml.Node{}\n}\n",
+			want:    []string{`import "example.com/go-yaml"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := Render("p", "", tt.imports, tt.content, "p_gombok.go")
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(string(content), want) {
+					t.Errorf("Render() does not contain %q\n%s", want, content)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(string(content), notWant) {
+					t.Errorf("Render() contains %q\n%s", notWant, content)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+//go:build go1.22
+
+package generate
+
+import "go/types"
+
+// unalias 는 별칭 타입이 가리키는 타입을 반환합니다.
+func unalias(t types.Type) types.Type {
+	return types.Unalias(t)
+}
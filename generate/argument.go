@@ -0,0 +1,111 @@
+package generate
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Arguments 는 어노테이션에 지정된 이름 있는 인자입니다. 예) @Builder(prefix="Set", build="Finish")
+// 값은 string, bool, []string 중 하나입니다.
+type Arguments map[string]any
+
+// ArgumentKind 는 어노테이션 인자가 가질 수 있는 값의 종류입니다.
+type ArgumentKind int
+
+const (
+	StringArgument ArgumentKind = iota
+	BoolArgument
+	// ListArgument 는 목록 값으로, 하나의 문자열도 허용합니다.
+	ListArgument
+)
+
+// String 은 ArgumentKind 를 오류 메시지에 사용할 이름으로 반환합니다.
+func (k ArgumentKind) String() string {
+	switch k {
+	case StringArgument:
+		return "string"
+	case BoolArgument:
+		return "bool"
+	case ListArgument:
+		return "list"
+	}
+
+	return fmt.Sprintf("ArgumentKind(%d)", int(k))
+}
+
+// ParseArgumentKind 는 manifest 에 작성된 인자의 종류를 읽습니다.
+func ParseArgumentKind(kind string) (ArgumentKind, error) {
+	for _, k := range []ArgumentKind{StringArgument, BoolArgument, ListArgument} {
+		if k.String() == kind {
+			return k, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown argument kind %q, must be string, bool or list", kind)
+}
+
+// Validate 는 인자가 어노테이션에서 허용된 이름과 종류인지 확인합니다.
+func (a Arguments) Validate(annotation string, allowed map[string]ArgumentKind) error {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	// 오류 메시지가 항상 같도록 이름 순서로 확인합니다.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		kind, ok := allowed[key]
+		if !ok {
+			return fmt.Errorf("@%s does not accept argument %s", annotation, key)
+		}
+
+		switch a[key].(type) {
+		case string:
+			if kind == StringArgument || kind == ListArgument {
+				continue
+			}
+		case bool:
+			if kind == BoolArgument {
+				continue
+			}
+		case []string:
+			if kind == ListArgument {
+				continue
+			}
+		}
+
+		return fmt.Errorf("@%s argument %s must be a %s", annotation, key, kind)
+	}
+
+	return nil
+}
+
+// String 은 문자열 인자를 반환합니다. 인자가 없다면 fallback 을 반환합니다.
+func (a Arguments) String(key, fallback string) string {
+	if value, ok := a[key].(string); ok {
+		return value
+	}
+
+	return fallback
+}
+
+// Bool 은 bool 인자를 반환합니다. 인자가 없다면 fallback 을 반환합니다.
+func (a Arguments) Bool(key string, fallback bool) bool {
+	if value, ok := a[key].(bool); ok {
+		return value
+	}
+
+	return fallback
+}
+
+// List 는 목록 인자를 반환합니다. 하나의 문자열은 값이 하나인 목록으로 반환합니다.
+func (a Arguments) List(key string) []string {
+	switch value := a[key].(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	}
+
+	return nil
+}
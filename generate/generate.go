@@ -2,13 +2,15 @@ package generate
 
 import (
 	"bytes"
+	"fmt"
 	stringpkg "github.com/YangTaeyoung/gombok/strings"
 	"go/ast"
 	"go/printer"
 	"go/token"
+	"go/types"
 	"reflect"
+	"regexp"
 	"strings"
-	"text/template"
 )
 
 type Field struct {
@@ -16,12 +18,42 @@ type Field struct {
 	Type      string
 	MustBuild bool
 	IsPointer bool
+	IsSlice   bool
+	IsMap     bool
+	// TypeName 은 필드 타입이 참조하는 같은 패키지의 타입 이름입니다.
+	TypeName  string
+	HasEquals bool
+	HasHash   bool
+	// MappedName 은 @Mapper 로 변환되는 대상 구조체의 필드 이름입니다.
+	MappedName string
+	// IsNillable 은 nil 과 비교할 수 있는 타입인지, IsComparable 은 == 로 비교할 수 있는 타입인지 나타냅니다.
+	// ElemComparable 은 slice, map 타입의 원소를 == 로 비교할 수 있는지 나타냅니다.
+	IsNillable     bool
+	IsComparable   bool
+	ElemComparable bool
+	// UseDeepEqual 은 == 로 비교할 수 없어 reflect.DeepEqual 로 비교해야 하는 필드인지 나타냅니다.
+	UseDeepEqual bool
+	// IsFloat, IsComplex 는 == 로 같은 -0 과 0 을 같은 값으로 바꿔 해시해야 하는 부동소수점 타입인지,
+	// IsDynamic 은 인터페이스나 타입 매개변수처럼 값의 타입을 알 수 없는 타입인지 나타냅니다. slice 타입은 원소를 기준으로 하며 @HashCode 에서만 사용합니다.
+	IsFloat   bool
+	IsComplex bool
+	IsDynamic bool
+
+	// typ 은 필드의 타입 정보입니다. 타입 정보가 없다면 nil 입니다.
+	typ types.Type
 }
 
 type StructFields struct {
 	StructName         string
 	Fields             []Field
 	DefaultConstructor bool
+	// TypeParams 는 제네릭 구조체의 타입 매개변수 선언이고, TypeArgs 는 타입 인자입니다. 예) [K comparable, V any], [K, V]
+	TypeParams string
+	TypeArgs   string
+	// Prefix 는 생성되는 메서드 이름의 접두사이고, ConstructorName 은 생성되는 생성자 함수의 이름입니다.
+	// 어노테이션 인자로 변경할 수 있습니다. 예) @Setter(prefix="Update")
+	Prefix          string
+	ConstructorName string
 }
 
 func exprToString(expr ast.Expr) string {
@@ -32,7 +64,206 @@ func exprToString(expr ast.Expr) string {
 	return buf.String()
 }
 
-func AllArgsConstructor(name string, fields []*ast.Field, isDefault bool) (string, error) {
+// typeParamList 는 타입 매개변수 선언을 제약 조건과 함께 문자열로 만듭니다. 제네릭이 아니라면 빈 문자열을 반환합니다.
+func typeParamList(typeParams *ast.FieldList) string {
+	if typeParams == nil || len(typeParams.List) == 0 {
+		return ""
+	}
+
+	params := make([]string, 0)
+	for _, param := range typeParams.List {
+		names := make([]string, 0)
+		for _, paramName := range param.Names {
+			names = append(names, paramName.Name)
+		}
+		params = append(params, strings.Join(names, ", ")+" "+exprToString(param.Type))
+	}
+
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// typeArgList 는 타입 매개변수를 타입 인자로 사용할 문자열로 만듭니다. 제네릭이 아니라면 빈 문자열을 반환합니다.
+func typeArgList(typeParams *ast.FieldList) string {
+	if typeParams == nil || len(typeParams.List) == 0 {
+		return ""
+	}
+
+	args := make([]string, 0)
+	for _, param := range typeParams.List {
+		for _, paramName := range param.Names {
+			args = append(args, paramName.Name)
+		}
+	}
+
+	return "[" + strings.Join(args, ", ") + "]"
+}
+
+// typeOf 는 타입 정보에서 타입 표현식의 타입을 구합니다. 타입 정보가 없거나 타입을 알 수 없다면 nil 을 반환합니다.
+func typeOf(expr ast.Expr, info *types.Info) types.Type {
+	if info == nil {
+		return nil
+	}
+
+	t := info.TypeOf(expr)
+	if t == nil || t == types.Typ[types.Invalid] {
+		return nil
+	}
+
+	return t
+}
+
+// newField 는 필드 타입의 종류를 판단하여 Field 를 만듭니다.
+// 타입 정보가 있다면 named 타입의 기반 타입까지 고려하고, 없다면 타입 표현식으로 판단합니다.
+func newField(name string, expr ast.Expr, info *types.Info) Field {
+	field := Field{Name: name, Type: exprToString(expr), TypeName: elementTypeName(expr)}
+
+	t := typeOf(expr, info)
+	if t == nil {
+		_, field.IsPointer = expr.(*ast.StarExpr)
+		field.IsSlice = isSlice(expr)
+		field.IsMap = isMap(expr)
+		field.IsNillable = field.IsPointer || field.IsSlice || field.IsMap
+		field.IsComparable = !field.IsSlice && !field.IsMap
+		field.ElemComparable = true
+		return field
+	}
+
+	field.typ = t
+	field.IsComparable = types.Comparable(t)
+	field.ElemComparable = true
+
+	// 타입 매개변수는 제약 조건과 관계없이 nil 과 비교할 수 없습니다.
+	if _, ok := t.(*types.TypeParam); ok {
+		return field
+	}
+
+	switch underlying := t.Underlying().(type) {
+	case *types.Pointer:
+		field.IsPointer = true
+		field.IsNillable = true
+	case *types.Slice:
+		field.IsSlice = true
+		field.IsNillable = true
+		field.ElemComparable = types.Comparable(underlying.Elem())
+	case *types.Map:
+		field.IsMap = true
+		field.IsNillable = true
+		field.ElemComparable = types.Comparable(underlying.Elem())
+	case *types.Interface, *types.Signature, *types.Chan:
+		field.IsNillable = true
+	}
+
+	return field
+}
+
+// collectFields 는 tagKey 태그가 ignore로 정의된 필드를 제외한 모든 필드를 모읍니다.
+func collectFields(fields []*ast.Field, tagKey string, info *types.Info) []Field {
+	allFields := make([]Field, 0)
+	for _, field := range fields {
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+
+			// 필드에 tagKey 태그가 있고 ignore로 정의되어 있다면 필드를 추가하지 않습니다.
+			if value, exists := tag.Lookup(tagKey); exists && strings.Contains(value, "ignore") {
+				continue
+			}
+		}
+
+		// embedded 필드
+		if field.Names == nil {
+			allFields = append(allFields, newField(embeddedFieldName(field.Type), field.Type, info))
+			continue
+		}
+
+		// 일반 필드
+		for _, fieldName := range field.Names {
+			allFields = append(allFields, newField(fieldName.Name, field.Type, info))
+		}
+	}
+
+	return allFields
+}
+
+// elementTypeName 은 필드 타입이 참조하는 같은 패키지의 타입 이름을 구합니다.
+// 포인터 타입은 가리키는 타입을, slice 와 map 타입은 원소 타입을 기준으로 합니다.
+func elementTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok {
+			return ident.Name
+		}
+	case *ast.ArrayType:
+		if ident, ok := t.Elt.(*ast.Ident); ok && t.Len == nil {
+			return ident.Name
+		}
+	case *ast.MapType:
+		if ident, ok := t.Value.(*ast.Ident); ok {
+			return ident.Name
+		}
+	}
+
+	return ""
+}
+
+// WithFields 는 @With 템플릿에 전달되는 데이터입니다.
+// CopyFields 는 복사본이 원본과 메모리를 공유하지 않도록 복사해야 하는 slice, map 필드이고,
+// Statements 는 복사본의 해당 필드를 깊은 복사본으로 바꾸는 코드입니다.
+type WithFields struct {
+	StructFields
+	CopyFields []Field
+	Statements []string
+}
+
+// isSlice 는 타입이 slice 타입인지 확인합니다.
+func isSlice(expr ast.Expr) bool {
+	arrayType, ok := expr.(*ast.ArrayType)
+	return ok && arrayType.Len == nil
+}
+
+// isMap 은 타입이 map 타입인지 확인합니다.
+func isMap(expr ast.Expr) bool {
+	_, ok := expr.(*ast.MapType)
+	return ok
+}
+
+// embeddedFieldName 은 embedded 필드의 타입에서 필드 이름을 구합니다.
+func embeddedFieldName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedFieldName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	}
+
+	return exprToString(expr)
+}
+
+// constructorArguments 는 생성자 어노테이션에서 사용할 수 있는 인자입니다.
+// default 는 패키지의 기본 생성자 New() 를 생성하고, name 은 생성자 함수의 이름을 지정합니다.
+var constructorArguments = map[string]ArgumentKind{
+	"default": BoolArgument,
+	"name":    StringArgument,
+}
+
+// constructorName 은 인자에 따라 생성자 함수의 이름을 결정합니다.
+func constructorName(args Arguments, isDefault bool, fallback string) string {
+	if isDefault {
+		fallback = "New"
+	}
+
+	return args.String("name", fallback)
+}
+
+func AllArgsConstructor(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, templates Templates) (string, error) {
+	if err := args.Validate("AllArgsConstructor", constructorArguments); err != nil {
+		return "", err
+	}
+	isDefault := args.Bool("default", false)
+
 	// 모든 필드를 리스트에 추가합니다.
 	allFields := make([]Field, 0)
 	for _, field := range fields {
@@ -58,10 +289,7 @@ func AllArgsConstructor(name string, fields []*ast.Field, isDefault bool) (strin
 	}
 
 	// 템플릿 파싱.
-	tmpl, err := template.New("allArgsConstructorTemplate").Funcs(template.FuncMap{
-		"LowerCamelCase": stringpkg.LowerCamel,
-		"ReceiverName":   stringpkg.ReceiverName,
-	}).Parse(allArgsConstructorTemplate)
+	tmpl, err := templates.parse("allArgsConstructor")
 	if err != nil {
 		return "", err
 	}
@@ -69,8 +297,11 @@ func AllArgsConstructor(name string, fields []*ast.Field, isDefault bool) (strin
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, StructFields{
 		StructName:         name,
+		TypeParams:         typeParamList(typeParams),
+		TypeArgs:           typeArgList(typeParams),
 		Fields:             allFields,
 		DefaultConstructor: isDefault,
+		ConstructorName:    constructorName(args, isDefault, "New"+name+"WithAllArgs"),
 	})
 
 	if err != nil {
@@ -81,7 +312,9 @@ func AllArgsConstructor(name string, fields []*ast.Field, isDefault bool) (strin
 	return buf.String(), nil
 }
 
-func RequiredArgsConstructor(name string, fields []*ast.Field, isDefault bool) (string, error) {
+// collectRequiredFields 는 validate 태그가 required로 정의된 필드를 모읍니다.
+// constructor 태그가 ignore로 정의된 필드는 제외됩니다.
+func collectRequiredFields(fields []*ast.Field) []Field {
 	requiredFields := make([]Field, 0)
 
 	for _, field := range fields {
@@ -102,16 +335,26 @@ func RequiredArgsConstructor(name string, fields []*ast.Field, isDefault bool) (
 				}
 
 				// 일반 필드
-				requiredFields = append(requiredFields, Field{Name: field.Names[0].Name, Type: exprToString(field.Type)})
+				for _, fieldName := range field.Names {
+					requiredFields = append(requiredFields, Field{Name: fieldName.Name, Type: exprToString(field.Type)})
+				}
 			}
 		}
 	}
 
+	return requiredFields
+}
+
+func RequiredArgsConstructor(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, templates Templates) (string, error) {
+	if err := args.Validate("RequiredArgsConstructor", constructorArguments); err != nil {
+		return "", err
+	}
+	isDefault := args.Bool("default", false)
+
+	requiredFields := collectRequiredFields(fields)
+
 	// 템플릿을 파싱합니다.
-	tmpl, err := template.New("requiredArgsConstructor").Funcs(template.FuncMap{
-		"LowerCamelCase": stringpkg.LowerCamel,
-		"ReceiverName":   stringpkg.ReceiverName,
-	}).Parse(requiredArgsConstructorTmpl)
+	tmpl, err := templates.parse("requiredArgsConstructor")
 	if err != nil {
 		return "", err
 	}
@@ -121,8 +364,11 @@ func RequiredArgsConstructor(name string, fields []*ast.Field, isDefault bool) (
 
 	err = tmpl.Execute(&buf, StructFields{
 		StructName:         name,
+		TypeParams:         typeParamList(typeParams),
+		TypeArgs:           typeArgList(typeParams),
 		Fields:             requiredFields,
 		DefaultConstructor: isDefault,
+		ConstructorName:    constructorName(args, isDefault, "New"+name+"WithRequiredArgs"),
 	})
 
 	if err != nil {
@@ -133,8 +379,13 @@ func RequiredArgsConstructor(name string, fields []*ast.Field, isDefault bool) (
 	return buf.String(), nil
 }
 
-func NoArgsConstructor(name string, isDefault bool) (string, error) {
-	tmpl, err := template.New("noArgsConstructorTemplate").Parse(noArgsConstructorTemplate)
+func NoArgsConstructor(name string, typeParams *ast.FieldList, args Arguments, templates Templates) (string, error) {
+	if err := args.Validate("NoArgsConstructor", constructorArguments); err != nil {
+		return "", err
+	}
+	isDefault := args.Bool("default", false)
+
+	tmpl, err := templates.parse("noArgsConstructor")
 	if err != nil {
 		return "", err
 	}
@@ -143,7 +394,10 @@ func NoArgsConstructor(name string, isDefault bool) (string, error) {
 
 	err = tmpl.Execute(&buf, StructFields{
 		StructName:         name,
+		TypeParams:         typeParamList(typeParams),
+		TypeArgs:           typeArgList(typeParams),
 		DefaultConstructor: isDefault,
+		ConstructorName:    constructorName(args, isDefault, "New"+name+"WithNoArgs"),
 	})
 
 	if err != nil {
@@ -154,7 +408,24 @@ func NoArgsConstructor(name string, isDefault bool) (string, error) {
 	return buf.String(), nil
 }
 
-func Builder(name string, fields []*ast.Field) (string, error) {
+// BuilderFields 는 @Builder 템플릿에 전달되는 데이터입니다. BuildName 은 구조체를 생성하는 메서드의 이름입니다.
+type BuilderFields struct {
+	StructFields
+	BuildName string
+}
+
+// builderArguments 는 @Builder 에서 사용할 수 있는 인자입니다.
+var builderArguments = map[string]ArgumentKind{
+	"prefix": StringArgument,
+	"build":  StringArgument,
+	"name":   StringArgument,
+}
+
+func Builder(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, info *types.Info, templates Templates) (string, error) {
+	if err := args.Validate("Builder", builderArguments); err != nil {
+		return "", err
+	}
+
 	allFields := make([]Field, 0)
 	for _, field := range fields {
 		if field.Tag != nil {
@@ -166,20 +437,21 @@ func Builder(name string, fields []*ast.Field) (string, error) {
 			}
 
 			// 필드에 builder 태그가 있고 must로 정의되어 있다면 필드를 추가합니다.
+			// 타입에 따라 nil 또는 zero value 인지 검사합니다.
 			if value, exists := tag.Lookup("builder"); exists && strings.Contains(value, "must") {
-				var isPointer bool
-				if strings.Contains(exprToString(field.Type), "*") {
-					isPointer = true
-				}
 				// embedded 필드
 				if field.Names == nil {
-					allFields = append(allFields, Field{Name: exprToString(field.Type), Type: exprToString(field.Type), MustBuild: true, IsPointer: isPointer})
+					mustField := newField(exprToString(field.Type), field.Type, info)
+					mustField.MustBuild = true
+					allFields = append(allFields, mustField)
 					continue
 				}
 
 				// 일반 필드
 				for _, fieldName := range field.Names {
-					allFields = append(allFields, Field{Name: fieldName.Name, Type: exprToString(field.Type), MustBuild: true, IsPointer: isPointer})
+					mustField := newField(fieldName.Name, field.Type, info)
+					mustField.MustBuild = true
+					allFields = append(allFields, mustField)
 				}
 				continue
 			}
@@ -197,18 +469,22 @@ func Builder(name string, fields []*ast.Field) (string, error) {
 		}
 	}
 
-	tmpl, err := template.New("z").Funcs(template.FuncMap{
-		"LowerCamelCase": stringpkg.LowerCamel,
-		"ReceiverName":   stringpkg.ReceiverName,
-	}).Parse(builderTemplate)
+	tmpl, err := templates.parse("builder")
 	if err != nil {
 		return "", err
 	}
 
 	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, StructFields{
-		StructName: name,
-		Fields:     allFields,
+	err = tmpl.Execute(&buf, BuilderFields{
+		StructFields: StructFields{
+			StructName:      name,
+			TypeParams:      typeParamList(typeParams),
+			TypeArgs:        typeArgList(typeParams),
+			Fields:          allFields,
+			Prefix:          args.String("prefix", "With"),
+			ConstructorName: args.String("name", "New"+name+"Builder"),
+		},
+		BuildName: args.String("build", "Build"),
 	})
 
 	if err != nil {
@@ -218,7 +494,11 @@ func Builder(name string, fields []*ast.Field) (string, error) {
 	return buf.String(), nil
 }
 
-func ToString(name string, fields []*ast.Field) (string, error) {
+func ToString(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, templates Templates) (string, error) {
+	if err := args.Validate("ToString", nil); err != nil {
+		return "", err
+	}
+
 	allFields := make([]Field, 0)
 	for _, field := range fields {
 		if field.Tag != nil {
@@ -241,7 +521,7 @@ func ToString(name string, fields []*ast.Field) (string, error) {
 		}
 	}
 
-	tmpl, err := template.New("toStringTemplate").Parse(toStringTemplate)
+	tmpl, err := templates.parse("toString")
 	if err != nil {
 		return "", err
 	}
@@ -249,6 +529,8 @@ func ToString(name string, fields []*ast.Field) (string, error) {
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, StructFields{
 		StructName: name,
+		TypeParams: typeParamList(typeParams),
+		TypeArgs:   typeArgList(typeParams),
 		Fields:     allFields,
 	})
 
@@ -259,11 +541,144 @@ func ToString(name string, fields []*ast.Field) (string, error) {
 	return buf.String(), nil
 }
 
-func Equals(name string) (string, error) {
-	tmpl, err := template.New("equalsTemplate").Funcs(template.FuncMap{
-		"LowerCamelCase": stringpkg.LowerCamel,
-		"ReceiverName":   stringpkg.ReceiverName,
-	}).Parse(equalsTemplate)
+func Equals(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, equalsTypes map[string]bool, info *types.Info, templates Templates) (string, error) {
+	if err := args.Validate("Equals", nil); err != nil {
+		return "", err
+	}
+
+	allFields := equalsFields(fields, equalsTypes, info)
+
+	tmpl, err := templates.parse("equals")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, StructFields{
+		StructName: name,
+		TypeParams: typeParamList(typeParams),
+		TypeArgs:   typeArgList(typeParams),
+		Fields:     allFields,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// equalsFields 는 @Equals 와 @HashCode 에서 비교할 필드와 각 필드의 비교 방법을 구합니다.
+func equalsFields(fields []*ast.Field, equalsTypes map[string]bool, info *types.Info) []Field {
+	allFields := collectFields(fields, "equals", info)
+	for i := range allFields {
+		field := &allFields[i]
+		field.HasEquals = equalsTypes[field.TypeName]
+
+		// Equals 가 없고 == 로 비교할 수 없는 타입은 reflect.DeepEqual 로 비교합니다.
+		if field.IsSlice || field.IsMap {
+			field.UseDeepEqual = !field.HasEquals && !field.ElemComparable
+		} else {
+			field.UseDeepEqual = !field.HasEquals && !field.IsComparable
+		}
+	}
+
+	return allFields
+}
+
+// hashKind 가 반환하는 해시 방법입니다.
+// floatHash, complexHash 는 -0 을 0 으로 바꿔 해시하고, dynamicHash 는 값의 타입에 따라 해시하며, unsupportedHash 는 해시할 수 없는 타입입니다.
+const (
+	floatHash       = "float"
+	complexHash     = "complex"
+	dynamicHash     = "dynamic"
+	unsupportedHash = "unsupported"
+)
+
+// hashKind 는 == 로 비교하는 타입의 값을 fmt 로 출력하여 해시할 수 없는 경우 해시 방법을 반환합니다. 출력하여 해시할 수 있다면 빈 문자열을 반환합니다.
+// -0 과 0 은 == 로 같지만 다르게 출력되고, 인터페이스는 이러한 값을 담을 수 있습니다.
+// 구조체와 배열은 필드와 원소를 출력하므로 부동소수점이나 인터페이스를 포함한다면 해시할 수 없습니다. 포인터는 주소를 비교하므로 가리키는 값은 확인하지 않습니다.
+func hashKind(t types.Type) string {
+	switch underlying := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case underlying.Info()&types.IsFloat != 0:
+			return floatHash
+		case underlying.Info()&types.IsComplex != 0:
+			return complexHash
+		}
+	case *types.Interface:
+		// 타입 매개변수의 underlying 타입은 제약 조건 인터페이스입니다.
+		return dynamicHash
+	case *types.Array:
+		if hashKind(underlying.Elem()) != "" {
+			return unsupportedHash
+		}
+	case *types.Struct:
+		for i := 0; i < underlying.NumFields(); i++ {
+			if hashKind(underlying.Field(i).Type()) != "" {
+				return unsupportedHash
+			}
+		}
+	}
+
+	return ""
+}
+
+// HashCode 는 Equals 가 같다고 판단하는 값이 항상 같은 해시를 갖도록, Equals 와 같은 방법으로 비교되는 필드만 해시합니다.
+// Equals 메서드나 reflect.DeepEqual 로 비교되는 필드는 fmt 로 출력한 값이 같다는 보장이 없으므로
+// 필드 타입에 @HashCode 가 없다면 코드를 생성하지 않고 오류를 반환합니다.
+func HashCode(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, equalsTypes map[string]bool, hashTypes map[string]bool, info *types.Info, templates Templates) (string, error) {
+	if err := args.Validate("HashCode", nil); err != nil {
+		return "", err
+	}
+
+	allFields := equalsFields(fields, equalsTypes, info)
+	for i := range allFields {
+		field := &allFields[i]
+		field.HasHash = hashTypes[field.TypeName]
+
+		switch {
+		case field.HasEquals && !field.HasHash:
+			return "", fmt.Errorf("field %s of %s is compared with %s.Equals but %s has no @HashCode", field.Name, name, field.TypeName, field.TypeName)
+		case field.UseDeepEqual:
+			return "", fmt.Errorf("field %s of %s is compared with reflect.DeepEqual and cannot be hashed consistently, add @HashCode to its type or an equals:\"ignore\" tag", field.Name, name)
+		case field.HasHash || field.typ == nil:
+			continue
+		}
+
+		// == 로 비교하는 필드는 fmt 로 출력한 값을 해시하므로, 같지만 다르게 출력되는 값을 찾습니다.
+		kinds := make([]string, 0, 2)
+		switch underlying := field.typ.Underlying().(type) {
+		case *types.Slice:
+			kinds = append(kinds, hashKind(underlying.Elem()))
+		case *types.Map:
+			// map 은 fmt 로 출력하여 해시하므로 키와 값 모두 출력이 같아야 합니다.
+			kinds = append(kinds, hashKind(underlying.Key()), hashKind(underlying.Elem()))
+			for i, kind := range kinds {
+				if kind != "" {
+					kinds[i] = unsupportedHash
+				}
+			}
+		default:
+			kinds = append(kinds, hashKind(field.typ))
+		}
+
+		for _, kind := range kinds {
+			switch kind {
+			case unsupportedHash:
+				return "", fmt.Errorf("field %s of %s contains floating-point or interface values that can be equal but print differently, add @HashCode to its type or an equals:\"ignore\" tag", field.Name, name)
+			case floatHash:
+				field.IsFloat = true
+			case complexHash:
+				field.IsComplex = true
+			case dynamicHash:
+				field.IsDynamic = true
+			}
+		}
+	}
+
+	tmpl, err := templates.parse("hashCode")
 	if err != nil {
 		return "", err
 	}
@@ -271,6 +686,9 @@ func Equals(name string) (string, error) {
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, StructFields{
 		StructName: name,
+		TypeParams: typeParamList(typeParams),
+		TypeArgs:   typeArgList(typeParams),
+		Fields:     allFields,
 	})
 
 	if err != nil {
@@ -280,7 +698,52 @@ func Equals(name string) (string, error) {
 	return buf.String(), nil
 }
 
-func Getter(name string, fields []*ast.Field) (string, error) {
+// ValidateValue 는 @Value 어노테이션이 지정된 구조체가 불변 구조체의 조건을 만족하는지 검사합니다.
+// 외부 패키지에서 값을 변경할 수 없도록 모든 필드는 unexported 필드여야 합니다.
+func ValidateValue(name string, fields []*ast.Field) error {
+	for _, field := range fields {
+		// embedded 필드
+		if field.Names == nil {
+			if embeddedName := embeddedFieldName(field.Type); ast.IsExported(embeddedName) {
+				return fmt.Errorf("@Value struct %s must not have exported field %s", name, embeddedName)
+			}
+			continue
+		}
+
+		// 일반 필드
+		for _, fieldName := range field.Names {
+			if fieldName.IsExported() {
+				return fmt.Errorf("@Value struct %s must not have exported field %s", name, fieldName.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetterFields 는 @Getter 템플릿에 전달되는 데이터입니다. ValueReceiver 가 true 라면 값 리시버를 사용합니다.
+type GetterFields struct {
+	StructFields
+	ValueReceiver bool
+}
+
+// getterArguments 는 @Getter 에서 사용할 수 있는 인자입니다. receiver 는 pointer 또는 value 입니다.
+var getterArguments = map[string]ArgumentKind{
+	"prefix":   StringArgument,
+	"receiver": StringArgument,
+}
+
+func Getter(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, templates Templates) (string, error) {
+	if err := args.Validate("Getter", getterArguments); err != nil {
+		return "", err
+	}
+
+	receiver := args.String("receiver", "pointer")
+	if receiver != "pointer" && receiver != "value" {
+		return "", fmt.Errorf("@Getter argument receiver must be pointer or value, got %q", receiver)
+	}
+
+	prefix := args.String("prefix", "Get")
 	allFields := make([]Field, 0)
 	for _, field := range fields {
 		if field.Tag != nil {
@@ -300,22 +763,30 @@ func Getter(name string, fields []*ast.Field) (string, error) {
 
 		// 일반 필드
 		for _, fieldName := range field.Names {
+			// 접두사가 없다면 exported 필드의 getter 는 필드와 이름이 같아지므로 만들지 않습니다.
+			if prefix+stringpkg.UpperCamel(fieldName.Name) == fieldName.Name {
+				continue
+			}
+
 			allFields = append(allFields, Field{Name: fieldName.Name, Type: exprToString(field.Type)})
 		}
 	}
 
-	tmpl, err := template.New("getterTemplate").Funcs(template.FuncMap{
-		"LowerCamelCase": stringpkg.LowerCamel,
-		"ReceiverName":   stringpkg.ReceiverName,
-	}).Parse(getterTemplate)
+	tmpl, err := templates.parse("getter")
 	if err != nil {
 		return "", err
 	}
 
 	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, StructFields{
-		StructName: name,
-		Fields:     allFields,
+	err = tmpl.Execute(&buf, GetterFields{
+		StructFields: StructFields{
+			StructName: name,
+			TypeParams: typeParamList(typeParams),
+			TypeArgs:   typeArgList(typeParams),
+			Fields:     allFields,
+			Prefix:     prefix,
+		},
+		ValueReceiver: receiver == "value",
 	})
 
 	if err != nil {
@@ -325,7 +796,16 @@ func Getter(name string, fields []*ast.Field) (string, error) {
 	return buf.String(), nil
 }
 
-func Setter(name string, fields []*ast.Field) (string, error) {
+// prefixArguments 는 메서드 이름의 접두사만 변경할 수 있는 어노테이션의 인자입니다.
+var prefixArguments = map[string]ArgumentKind{
+	"prefix": StringArgument,
+}
+
+func Setter(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, templates Templates) (string, error) {
+	if err := args.Validate("Setter", prefixArguments); err != nil {
+		return "", err
+	}
+
 	allFields := make([]Field, 0)
 	for _, field := range fields {
 		if field.Tag != nil {
@@ -349,10 +829,7 @@ func Setter(name string, fields []*ast.Field) (string, error) {
 		}
 	}
 
-	tmpl, err := template.New("setterTemplate").Funcs(template.FuncMap{
-		"LowerCamelCase": stringpkg.LowerCamel,
-		"ReceiverName":   stringpkg.ReceiverName,
-	}).Parse(setterTemplate)
+	tmpl, err := templates.parse("setter")
 	if err != nil {
 		return "", err
 	}
@@ -360,7 +837,545 @@ func Setter(name string, fields []*ast.Field) (string, error) {
 	var buf bytes.Buffer
 	err = tmpl.Execute(&buf, StructFields{
 		StructName: name,
+		TypeParams: typeParamList(typeParams),
+		TypeArgs:   typeArgList(typeParams),
 		Fields:     allFields,
+		Prefix:     args.String("prefix", "Set"),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// With 는 필드 값을 바꾼 복사본을 반환하는 메서드를 생성하며, 복사본의 slice, map 필드는 원본과 공유되지 않도록 깊은 복사합니다.
+// 생성된 코드가 참조하는 패키지의 import 경로를 함께 반환합니다.
+func With(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, cloneTypes map[string]bool, pkg *types.Package, aliases map[string]string, info *types.Info, templates Templates) (string, []string, error) {
+	if err := args.Validate("With", prefixArguments); err != nil {
+		return "", nil, err
+	}
+
+	importPaths := make([]string, 0)
+	qualifier := packageQualifier(pkg, aliases, &importPaths)
+
+	allFields := make([]Field, 0)
+	copyFields := make([]Field, 0)
+	statements := make([]string, 0)
+	for _, field := range fields {
+		// embedded 필드는 With 메서드를 생성하지 않습니다.
+		if field.Names == nil {
+			continue
+		}
+
+		// slice, map 필드는 with 태그와 관계없이 @Clone 과 같은 방식으로 깊은 복사합니다.
+		for _, fieldName := range field.Names {
+			if copyField := newField(fieldName.Name, field.Type, info); copyField.IsSlice || copyField.IsMap {
+				copyFields = append(copyFields, copyField)
+				if copyField.typ != nil {
+					statements = append(statements, cloneStatement(stringpkg.ReceiverName(name)+"."+fieldName.Name, copyField.typ, cloneTypes, pkg, qualifier, 0))
+				}
+			}
+		}
+
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+
+			// 필드에 with 태그가 있고 ignore로 정의되어 있다면 필드를 추가하지 않습니다.
+			if value, exists := tag.Lookup("with"); exists && strings.Contains(value, "ignore") {
+				continue
+			}
+		}
+
+		// 일반 필드
+		for _, fieldName := range field.Names {
+			allFields = append(allFields, Field{Name: fieldName.Name, Type: exprToString(field.Type)})
+		}
+	}
+
+	tmpl, err := templates.parse("with")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, WithFields{
+		StructFields: StructFields{
+			StructName: name,
+			TypeParams: typeParamList(typeParams),
+			TypeArgs:   typeArgList(typeParams),
+			Fields:     allFields,
+			Prefix:     args.String("prefix", "With"),
+		},
+		CopyFields: copyFields,
+		Statements: statements,
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return buf.String(), importPaths, nil
+}
+
+// OptionsFields 는 @Options 템플릿에 전달되는 데이터입니다.
+// RequiredFields 는 생성자의 매개변수가 되는 필드이고, Fields 는 Option 함수가 생성되는 필드입니다.
+type OptionsFields struct {
+	StructFields
+	RequiredFields []Field
+}
+
+// optionsArguments 는 @Options 에서 사용할 수 있는 인자입니다.
+var optionsArguments = map[string]ArgumentKind{
+	"prefix": StringArgument,
+	"name":   StringArgument,
+}
+
+// Options 는 Option 함수와 Option 을 적용하는 생성자를 생성합니다.
+// taken 은 같은 패키지의 다른 @Options 구조체가 생성하는 Option 함수 이름입니다.
+// 기본 접두사로 만든 이름이 겹친다면 접두사에 구조체 이름을 붙이고(예: WithServerTimeout), 직접 지정한 접두사로 만든 이름이 겹친다면 오류를 반환합니다.
+func Options(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, taken map[string]bool, templates Templates) (string, error) {
+	if err := args.Validate("Options", optionsArguments); err != nil {
+		return "", err
+	}
+
+	requiredFields, optionFields := collectOptionFields(fields)
+
+	prefix := args.String("prefix", "With")
+	for _, field := range optionFields {
+		funcName := prefix + stringpkg.UpperCamel(field.Name)
+		if !taken[funcName] {
+			continue
+		}
+
+		if _, explicit := args["prefix"]; explicit {
+			return "", fmt.Errorf("option function %s of %s is also generated for another @Options struct in the package, set a different prefix", funcName, name)
+		}
+		prefix += name
+		break
+	}
+
+	tmpl, err := templates.parse("options")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, OptionsFields{
+		StructFields: StructFields{
+			StructName:      name,
+			TypeParams:      typeParamList(typeParams),
+			TypeArgs:        typeArgList(typeParams),
+			Fields:          optionFields,
+			Prefix:          prefix,
+			ConstructorName: args.String("name", "New"+name),
+		},
+		RequiredFields: requiredFields,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// OptionFunctions 는 @Options 가 기본 접두사 또는 지정한 접두사로 생성하는 Option 함수의 이름을 반환합니다.
+func OptionFunctions(fields []*ast.Field, args Arguments) []string {
+	_, optionFields := collectOptionFields(fields)
+
+	prefix := args.String("prefix", "With")
+	funcNames := make([]string, 0, len(optionFields))
+	for _, field := range optionFields {
+		funcNames = append(funcNames, prefix+stringpkg.UpperCamel(field.Name))
+	}
+
+	return funcNames
+}
+
+// collectOptionFields 는 생성자의 매개변수가 되는 필드와 Option 함수가 생성되는 필드를 모읍니다.
+func collectOptionFields(fields []*ast.Field) ([]Field, []Field) {
+	requiredFields := collectRequiredFields(fields)
+	isRequired := make(map[string]bool)
+	for _, field := range requiredFields {
+		isRequired[field.Name] = true
+	}
+
+	optionFields := make([]Field, 0)
+	for _, field := range fields {
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+
+			// 필드에 options 태그가 있고 ignore로 정의되어 있다면 필드를 추가하지 않습니다.
+			if value, exists := tag.Lookup("options"); exists && strings.Contains(value, "ignore") {
+				continue
+			}
+		}
+
+		// embedded 필드
+		if field.Names == nil {
+			if !isRequired[exprToString(field.Type)] {
+				optionFields = append(optionFields, Field{Name: embeddedFieldName(field.Type), Type: exprToString(field.Type)})
+			}
+			continue
+		}
+
+		// 일반 필드, 생성자의 매개변수로 받는 필드는 Option 함수를 생성하지 않습니다.
+		for _, fieldName := range field.Names {
+			if !isRequired[fieldName.Name] {
+				optionFields = append(optionFields, Field{Name: fieldName.Name, Type: exprToString(field.Type)})
+			}
+		}
+	}
+
+	return requiredFields, optionFields
+}
+
+// EnumConstant 는 @Enum 타입으로 선언된 상수입니다.
+// Value 는 String, Parse, MarshalText 에서 사용하는 상수의 문자열 이름입니다.
+type EnumConstant struct {
+	Name  string
+	Value string
+}
+
+// EnumFields 는 @Enum 템플릿에 전달되는 데이터입니다.
+type EnumFields struct {
+	TypeName       string
+	UnderlyingType string
+	Constants      []EnumConstant
+}
+
+// enumTagPattern 은 상수 주석에서 문자열 이름을 지정하는 enum 태그를 찾습니다.
+var enumTagPattern = regexp.MustCompile(`enum:"([^"]*)"`)
+
+// enumName 은 상수 주석의 enum 태그로 지정된 문자열 이름을 구합니다.
+func enumName(spec *ast.ValueSpec) (string, bool) {
+	for _, commentGroup := range []*ast.CommentGroup{spec.Comment, spec.Doc} {
+		if commentGroup == nil {
+			continue
+		}
+
+		for _, comment := range commentGroup.List {
+			if matches := enumTagPattern.FindStringSubmatch(comment.Text); matches != nil {
+				return matches[1], true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// Enum 은 열거형 타입과 상수에 대해 문자열 변환과 검증 메서드를 생성합니다.
+// 값이 같은 상수는 switch 의 case 가 겹치므로 먼저 선언된 상수만 사용합니다. 예) const Default Level = Low
+func Enum(name string, underlyingType ast.Expr, specs []*ast.ValueSpec, args Arguments, info *types.Info, templates Templates) (string, error) {
+	if err := args.Validate("Enum", nil); err != nil {
+		return "", err
+	}
+
+	constants := make([]EnumConstant, 0)
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		// 상수 주석에 enum 태그가 있다면 상수 이름 대신 태그 값을 사용합니다.
+		value, hasTag := enumName(spec)
+		for _, constName := range spec.Names {
+			if constName.Name == "_" {
+				continue
+			}
+
+			if constant, ok := info.Defs[constName].(*types.Const); ok {
+				if seen[constant.Val().ExactString()] {
+					continue
+				}
+				seen[constant.Val().ExactString()] = true
+			}
+
+			if !hasTag {
+				value = constName.Name
+			}
+			constants = append(constants, EnumConstant{Name: constName.Name, Value: value})
+		}
+	}
+
+	if len(constants) == 0 {
+		return "", fmt.Errorf("@Enum type %s has no constants", name)
+	}
+
+	tmpl, err := templates.parse("enum")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, EnumFields{
+		TypeName:       name,
+		UnderlyingType: exprToString(underlyingType),
+		Constants:      constants,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// CloneFields 는 @Clone 템플릿에 전달되는 데이터입니다.
+// Statements 는 얕은 복사본의 각 필드를 깊은 복사본으로 바꾸는 코드입니다.
+type CloneFields struct {
+	StructFields
+	Statements []string
+}
+
+// cloneStatement 는 target 에 담긴 얕은 복사본을 타입에 맞는 깊은 복사본으로 바꾸는 코드를 생성합니다.
+// 복사가 필요 없는 타입이면 빈 문자열을 반환하며, depth 는 중첩된 임시 변수의 이름이 겹치지 않도록 사용합니다.
+// qualifier 는 다른 패키지의 타입을 참조할 이름을 반환합니다.
+func cloneStatement(target string, t types.Type, cloneTypes map[string]bool, pkg *types.Package, qualifier types.Qualifier, depth int) string {
+	t = unalias(t)
+	if named, ok := t.(*types.Named); ok {
+		// 같은 패키지에서 Clone 이 생성되는 타입은 해당 Clone 을 호출합니다.
+		if named.Obj().Pkg() == pkg && cloneTypes[named.Obj().Name()] {
+			return fmt.Sprintf("%s = %s.Clone()\n", target, target)
+		}
+
+		// Clone 이 없는 구조체는 얕은 복사합니다.
+		if _, isStruct := named.Underlying().(*types.Struct); isStruct {
+			return ""
+		}
+	}
+
+	switch underlying := t.Underlying().(type) {
+	case *types.Pointer:
+		value := fmt.Sprintf("value%d", depth)
+		return fmt.Sprintf("if %s != nil {\n%s := *%s\n%s%s = &%s\n}\n",
+			target, value, target, cloneStatement(value, underlying.Elem(), cloneTypes, pkg, qualifier, depth+1), target, value)
+	case *types.Array:
+		// 배열은 값으로 복사되므로 원소만 깊은 복사합니다.
+		index := fmt.Sprintf("index%d", depth)
+		element := cloneStatement(fmt.Sprintf("%s[%s]", target, index), underlying.Elem(), cloneTypes, pkg, qualifier, depth+1)
+		if element == "" {
+			return ""
+		}
+		return fmt.Sprintf("for %s := range %s {\n%s}\n", index, target, element)
+	case *types.Slice:
+		index := fmt.Sprintf("index%d", depth)
+		slice := fmt.Sprintf("slice%d", depth)
+		var loop string
+		if element := cloneStatement(fmt.Sprintf("%s[%s]", slice, index), underlying.Elem(), cloneTypes, pkg, qualifier, depth+1); element != "" {
+			loop = fmt.Sprintf("for %s := range %s {\n%s}\n", index, slice, element)
+		}
+		return fmt.Sprintf("if %s != nil {\n%s := make(%s, len(%s))\ncopy(%s, %s)\n%s%s = %s\n}\n",
+			target, slice, types.TypeString(t, qualifier), target, slice, target, loop, target, slice)
+	case *types.Map:
+		copied := fmt.Sprintf("map%d", depth)
+		key := fmt.Sprintf("key%d", depth)
+		value := fmt.Sprintf("value%d", depth)
+		return fmt.Sprintf("if %s != nil {\n%s := make(%s, len(%s))\nfor %s, %s := range %s {\n%s%s[%s] = %s\n}\n%s = %s\n}\n",
+			target, copied, types.TypeString(t, qualifier), target, key, value, target, cloneStatement(value, underlying.Elem(), cloneTypes, pkg, qualifier, depth+1), copied, key, value, target, copied)
+	}
+
+	// 기본 타입, 인터페이스, 함수, 채널, 타입 매개변수는 얕은 복사합니다.
+	return ""
+}
+
+// Clone 은 구조체의 깊은 복사본을 반환하는 Clone 메서드를 생성합니다.
+// aliases 는 소스 파일에서 별칭으로 import 한 패키지의 import 경로별 별칭이며, 생성된 코드가 참조하는 패키지의 import 경로를 함께 반환합니다.
+func Clone(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, cloneTypes map[string]bool, pkg *types.Package, aliases map[string]string, info *types.Info, templates Templates) (string, []string, error) {
+	if err := args.Validate("Clone", nil); err != nil {
+		return "", nil, err
+	}
+
+	importPaths := make([]string, 0)
+	qualifier := packageQualifier(pkg, aliases, &importPaths)
+
+	statements := make([]string, 0)
+	for _, field := range fields {
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+
+			// 필드에 clone 태그가 있고 shallow로 정의되어 있다면 얕은 복사만 합니다.
+			if value, exists := tag.Lookup("clone"); exists && strings.Contains(value, "shallow") {
+				continue
+			}
+		}
+
+		// 타입을 알 수 없는 필드는 얕은 복사합니다.
+		fieldType := typeOf(field.Type, info)
+		if fieldType == nil {
+			continue
+		}
+
+		// embedded 필드
+		if field.Names == nil {
+			if statement := cloneStatement("clone."+embeddedFieldName(field.Type), fieldType, cloneTypes, pkg, qualifier, 0); statement != "" {
+				statements = append(statements, statement)
+			}
+			continue
+		}
+
+		// 일반 필드
+		for _, fieldName := range field.Names {
+			if statement := cloneStatement("clone."+fieldName.Name, fieldType, cloneTypes, pkg, qualifier, 0); statement != "" {
+				statements = append(statements, statement)
+			}
+		}
+	}
+
+	tmpl, err := templates.parse("clone")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, CloneFields{
+		StructFields: StructFields{
+			StructName: name,
+			TypeParams: typeParamList(typeParams),
+			TypeArgs:   typeArgList(typeParams),
+		},
+		Statements: statements,
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return buf.String(), importPaths, nil
+}
+
+// sameType 은 두 타입 표현식이 같은 타입인지 확인합니다. 타입 정보가 없다면 표현식을 비교합니다.
+func sameType(x, y ast.Expr, info *types.Info) bool {
+	xType, yType := typeOf(x, info), typeOf(y, info)
+	if xType == nil || yType == nil {
+		return exprToString(x) == exprToString(y)
+	}
+
+	if types.Identical(xType, yType) {
+		return true
+	}
+
+	// 서로 다른 제네릭 구조체의 타입 매개변수는 이름이 같아도 다른 타입이므로 표현식을 비교합니다.
+	return hasTypeParam(xType) && hasTypeParam(yType) && exprToString(x) == exprToString(y)
+}
+
+// hasTypeParam 은 타입이 타입 매개변수를 포함하는지 확인합니다.
+func hasTypeParam(t types.Type) bool {
+	switch t := unalias(t).(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Pointer:
+		return hasTypeParam(t.Elem())
+	case *types.Slice:
+		return hasTypeParam(t.Elem())
+	case *types.Array:
+		return hasTypeParam(t.Elem())
+	case *types.Map:
+		return hasTypeParam(t.Key()) || hasTypeParam(t.Elem())
+	case *types.Chan:
+		return hasTypeParam(t.Elem())
+	case *types.Named:
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			if hasTypeParam(t.TypeArgs().At(i)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MapperFields 는 @Mapper 템플릿에 전달되는 데이터입니다.
+type MapperFields struct {
+	StructFields
+	TargetName string
+	// TargetTypeArgs 는 제네릭 대상 구조체에 사용할 타입 인자입니다.
+	TargetTypeArgs string
+}
+
+func Mapper(name string, typeParams *ast.FieldList, fields []*ast.Field, target string, targetTypeParams *ast.FieldList, targetFields []*ast.Field, info *types.Info, templates Templates) (string, error) {
+	// 제네릭 대상 구조체는 원본 구조체와 같은 타입 매개변수를 사용해야 합니다.
+	if typeArgList(typeParams) != typeArgList(targetTypeParams) {
+		return "", fmt.Errorf("@Mapper %s to %s: type parameters %s and %s do not match", name, target, typeArgList(typeParams), typeArgList(targetTypeParams))
+	}
+
+	// 대상 구조체의 필드 이름별 타입
+	targetTypes := make(map[string]ast.Expr)
+	for _, field := range targetFields {
+		if field.Names == nil {
+			targetTypes[embeddedFieldName(field.Type)] = field.Type
+			continue
+		}
+
+		for _, fieldName := range field.Names {
+			targetTypes[fieldName.Name] = field.Type
+		}
+	}
+
+	mappedFields := make([]Field, 0)
+	for _, field := range fields {
+		var mappedName string
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+
+			// 필드에 mapper 태그가 있고 ignore로 정의되어 있다면 필드를 변환하지 않습니다.
+			// ignore 가 아니라면 태그 값을 대상 구조체의 필드 이름으로 사용합니다.
+			if value, exists := tag.Lookup("mapper"); exists {
+				if value == "ignore" {
+					continue
+				}
+				mappedName = value
+			}
+		}
+
+		names := make([]string, 0)
+		if field.Names == nil {
+			// embedded 필드
+			names = append(names, embeddedFieldName(field.Type))
+		} else {
+			// 일반 필드
+			for _, fieldName := range field.Names {
+				names = append(names, fieldName.Name)
+			}
+		}
+
+		for _, fieldName := range names {
+			targetName := fieldName
+			if mappedName != "" {
+				targetName = mappedName
+			}
+
+			targetType, exists := targetTypes[targetName]
+			if !exists {
+				// 이름이 같은 필드가 없다면 변환하지 않지만, 태그로 지정한 필드는 반드시 존재해야 합니다.
+				if mappedName != "" {
+					return "", fmt.Errorf("@Mapper %s to %s: field %s is mapped to %s.%s which does not exist", name, target, fieldName, target, targetName)
+				}
+				continue
+			}
+
+			if !sameType(field.Type, targetType, info) {
+				return "", fmt.Errorf("@Mapper %s to %s: field %s has type %s but %s.%s has type %s", name, target, fieldName, exprToString(field.Type), target, targetName, exprToString(targetType))
+			}
+
+			mappedFields = append(mappedFields, Field{Name: fieldName, Type: exprToString(targetType), MappedName: targetName})
+		}
+	}
+
+	tmpl, err := templates.parse("mapper")
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, MapperFields{
+		StructFields: StructFields{
+			StructName: name,
+			TypeParams: typeParamList(typeParams),
+			TypeArgs:   typeArgList(typeParams),
+			Fields:     mappedFields,
+		},
+		TargetName:     target,
+		TargetTypeArgs: typeArgList(targetTypeParams),
 	})
 
 	if err != nil {
@@ -369,3 +1384,169 @@ func Setter(name string, fields []*ast.Field) (string, error) {
 
 	return buf.String(), nil
 }
+
+// DelegateMethod 는 @Delegate 로 생성되는 위임 메서드입니다.
+type DelegateMethod struct {
+	Name            string
+	Field           string
+	Params          string
+	Args            string
+	Results         string
+	PointerReceiver bool
+}
+
+// DelegateFields 는 @Delegate 템플릿에 전달되는 데이터입니다.
+type DelegateFields struct {
+	StructFields
+	Methods []DelegateMethod
+}
+
+// packageQualifier 는 생성된 코드에서 다른 패키지를 참조할 이름을 반환하는 types.Qualifier 를 만듭니다.
+// 소스 파일에서 별칭으로 import 한 패키지는 별칭으로, 나머지는 패키지 이름으로 참조하며 참조한 패키지의 import 경로를 importPaths 에 모읍니다.
+func packageQualifier(pkg *types.Package, aliases map[string]string, importPaths *[]string) types.Qualifier {
+	return func(other *types.Package) string {
+		if other == pkg {
+			return ""
+		}
+
+		found := false
+		for _, importPath := range *importPaths {
+			if importPath == other.Path() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*importPaths = append(*importPaths, other.Path())
+		}
+
+		if alias, ok := aliases[other.Path()]; ok {
+			return alias
+		}
+
+		return other.Name()
+	}
+}
+
+// Delegate 는 delegate 태그가 true로 정의된 필드의 메서드를 구조체에서 호출할 수 있도록 위임 메서드를 생성합니다.
+// declared 는 구조체에 직접 선언된 메서드와 다른 어노테이션이 생성한 메서드로, 해당 메서드는 위임하지 않습니다.
+// aliases 는 소스 파일에서 별칭으로 import 한 패키지의 import 경로별 별칭입니다.
+// 생성된 코드가 참조하는 패키지의 import 경로를 함께 반환합니다.
+func Delegate(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, pkg *types.Package, aliases map[string]string, declared map[string]bool, templates Templates) (string, []string, error) {
+	if err := args.Validate("Delegate", nil); err != nil {
+		return "", nil, err
+	}
+
+	typeName, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return "", nil, fmt.Errorf("@Delegate type %s not found in package %s", name, pkg.Path())
+	}
+
+	structType, ok := typeName.Type().Underlying().(*types.Struct)
+	if !ok {
+		return "", nil, fmt.Errorf("@Delegate type %s is not a struct", name)
+	}
+
+	fieldTypes := make(map[string]types.Type)
+	for i := 0; i < structType.NumFields(); i++ {
+		fieldTypes[structType.Field(i).Name()] = structType.Field(i).Type()
+	}
+
+	importPaths := make([]string, 0)
+	qualifier := packageQualifier(pkg, aliases, &importPaths)
+
+	methods := make([]DelegateMethod, 0)
+	delegatedBy := make(map[string]string)
+	for _, field := range fields {
+		if field.Tag == nil || field.Names == nil {
+			continue
+		}
+
+		// 필드에 delegate 태그가 있고 true로 정의되어 있다면 필드의 메서드를 위임합니다.
+		tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+		if value, exists := tag.Lookup("delegate"); !exists || value != "true" {
+			continue
+		}
+
+		for _, fieldName := range field.Names {
+			fieldType := fieldTypes[fieldName.Name]
+
+			// 포인터와 인터페이스가 아닌 필드는 포인터 receiver 메서드를 포인터 receiver 로 위임합니다.
+			_, isPointer := fieldType.(*types.Pointer)
+			addressable := !isPointer && !types.IsInterface(fieldType)
+			methodSet := types.NewMethodSet(fieldType)
+			valueMethodSet := methodSet
+			if addressable {
+				methodSet = types.NewMethodSet(types.NewPointer(fieldType))
+			}
+
+			for i := 0; i < methodSet.Len(); i++ {
+				method := methodSet.At(i).Obj().(*types.Func)
+				if declared[method.Name()] || (!method.Exported() && method.Pkg() != pkg) {
+					continue
+				}
+
+				if other, exists := delegatedBy[method.Name()]; exists {
+					return "", nil, fmt.Errorf("@Delegate %s: method %s is delegated by both %s and %s", name, method.Name(), other, fieldName.Name)
+				}
+				delegatedBy[method.Name()] = fieldName.Name
+
+				signature := method.Type().(*types.Signature)
+				params := make([]string, 0)
+				args := make([]string, 0)
+				for j := 0; j < signature.Params().Len(); j++ {
+					arg := fmt.Sprintf("arg%d", j)
+					paramType := signature.Params().At(j).Type()
+					if signature.Variadic() && j == signature.Params().Len()-1 {
+						params = append(params, arg+" ..."+types.TypeString(paramType.(*types.Slice).Elem(), qualifier))
+						args = append(args, arg+"...")
+						continue
+					}
+
+					params = append(params, arg+" "+types.TypeString(paramType, qualifier))
+					args = append(args, arg)
+				}
+
+				results := make([]string, 0)
+				for j := 0; j < signature.Results().Len(); j++ {
+					results = append(results, types.TypeString(signature.Results().At(j).Type(), qualifier))
+				}
+
+				resultList := strings.Join(results, ", ")
+				if len(results) > 1 {
+					resultList = "(" + resultList + ")"
+				}
+
+				methods = append(methods, DelegateMethod{
+					Name:            method.Name(),
+					Field:           fieldName.Name,
+					Params:          strings.Join(params, ", "),
+					Args:            strings.Join(args, ", "),
+					Results:         resultList,
+					PointerReceiver: addressable && valueMethodSet.Lookup(method.Pkg(), method.Name()) == nil,
+				})
+			}
+		}
+	}
+
+	tmpl, err := templates.parse("delegate")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, DelegateFields{
+		StructFields: StructFields{
+			StructName: name,
+			TypeParams: typeParamList(typeParams),
+			TypeArgs:   typeArgList(typeParams),
+		},
+		Methods: methods,
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return buf.String(), importPaths, nil
+}
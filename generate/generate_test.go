@@ -0,0 +1,332 @@
+package generate
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	filepkg "github.com/YangTaeyoung/gombok/file"
+)
+
+// checkSource 는 소스 코드를 파싱하고 타입 검사한 결과를 반환합니다.
+func checkSource(t *testing.T, source string) (*ast.File, *types.Package, *types.Info) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "source.go", source, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue), Defs: make(map[*ast.Ident]types.Object), Uses: make(map[*ast.Ident]types.Object)}
+	pkg, err := (&types.Config{}).Check("main", fset, []*ast.File{file}, info)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+
+	return file, pkg, info
+}
+
+// typeSpec 는 파일에서 name 타입의 선언을 찾습니다.
+func typeSpec(t *testing.T, file *ast.File, name string) *ast.TypeSpec {
+	t.Helper()
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			if spec := spec.(*ast.TypeSpec); spec.Name.Name == name {
+				return spec
+			}
+		}
+	}
+
+	t.Fatalf("type %s is not declared", name)
+	return nil
+}
+
+// structFields 는 구조체 타입 선언의 필드를 반환합니다.
+func structFields(t *testing.T, spec *ast.TypeSpec) []*ast.Field {
+	t.Helper()
+
+	structType, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		t.Fatalf("%s is not a struct", spec.Name.Name)
+	}
+
+	return structType.Fields.List
+}
+
+// runGenerated 는 소스 코드와 생성된 코드, main 함수로 프로그램을 만들어 실행하고 출력을 반환합니다.
+func runGenerated(t *testing.T, source string, generated string, main string, imports ...string) string {
+	t.Helper()
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command is not available")
+	}
+
+	importPackages := make([]filepkg.ImportPackage, 0, len(imports))
+	for _, path := range imports {
+		importPackages = append(importPackages, filepkg.ImportPackage{Path: path, Name: filepath.Base(path)})
+	}
+
+	dir := t.TempDir()
+	content, err := filepkg.Render("main", "", importPackages, generated+"\n"+main, filepath.Join(dir, "generated.go"))
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	files := map[string]string{
+		"go.mod":       "module example.com/generated\n\ngo 1.21\n",
+		"source.go":    source,
+		"generated.go": string(content),
+	}
+	for name, text := range files {
+		if err = os.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goBin, "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run error = %v\n%s\n%s", err, output, content)
+	}
+
+	return strings.TrimSpace(string(output))
+}
+
+func TestHashCodeAgreesWithEquals(t *testing.T) {
+	source := `package main
+
+type Point struct {
+	X, Y float64
+}
+
+type Key struct {
+	F  float64
+	F32 float32
+	C  complex128
+	I  any
+	S  []float64
+	D  []any
+	N  int
+	P  *Point
+	M  map[string]int
+}
+`
+	file, _, info := checkSource(t, source)
+	fields := structFields(t, typeSpec(t, file, "Key"))
+	equalsTypes := map[string]bool{"Key": true}
+
+	equals, err := Equals("Key", nil, fields, Arguments{}, equalsTypes, info, nil)
+	if err != nil {
+		t.Fatalf("Equals() error = %v", err)
+	}
+	hash, err := HashCode("Key", nil, fields, Arguments{}, equalsTypes, equalsTypes, info, nil)
+	if err != nil {
+		t.Fatalf("HashCode() error = %v", err)
+	}
+
+	main := `
+func main() {
+	negativeZero := math.Copysign(0, -1)
+	point := &Point{}
+	pairs := [][2]Key{
+		{{F: 0}, {F: negativeZero}},
+		{{F32: 0}, {F32: float32(negativeZero)}},
+		{{C: complex(0, 0)}, {C: complex(negativeZero, negativeZero)}},
+		{{I: 0.0}, {I: negativeZero}},
+		{{I: "a"}, {I: "a"}},
+		{{S: []float64{0, 1}}, {S: []float64{negativeZero, 1}}},
+		{{D: []any{0.0}}, {D: []any{negativeZero}}},
+		{{N: 1, P: point}, {N: 1, P: point}},
+		{{M: map[string]int{"a": 1, "b": 2}}, {M: map[string]int{"b": 2, "a": 1}}},
+	}
+
+	for i, pair := range pairs {
+		if !pair[0].Equals(pair[1]) {
+			fmt.Printf("pair %d is not equal\n", i)
+			continue
+		}
+		if pair[0].Hash() != pair[1].Hash() {
+			fmt.Printf("pair %d is equal but has different hashes\n", i)
+		}
+	}
+	fmt.Print("done")
+}
+`
+	if output := runGenerated(t, source, equals+hash, main, "math"); output != "done" {
+		t.Errorf("generated Equals and Hash disagree:\n%s", output)
+	}
+}
+
+func TestHashCodeRejectsInconsistentFields(t *testing.T) {
+	source := `package main
+
+type Point struct {
+	X, Y float64
+}
+
+type Named struct {
+	Name string
+}
+
+type Mid struct {
+	N int
+}
+
+type Outer struct {
+	M Mid
+}
+
+type WithPoint struct {
+	P Point
+}
+
+type WithArray struct {
+	A [2]float64
+}
+
+type WithMap struct {
+	M map[float64]string
+}
+
+type WithSlice struct {
+	S []Point
+}
+
+type WithDeepEqual struct {
+	M map[string][]int
+}
+
+type Fine struct {
+	N  Named
+	A  [2]int
+	P  *Point
+	Ignored Point ` + "`equals:\"ignore\"`" + `
+}
+`
+	file, _, info := checkSource(t, source)
+	equalsTypes := map[string]bool{"Mid": true}
+
+	tests := []struct {
+		name    string
+		wantErr string
+	}{
+		{name: "Outer", wantErr: "compared with Mid.Equals but Mid has no @HashCode"},
+		{name: "WithPoint", wantErr: "floating-point or interface values"},
+		{name: "WithArray", wantErr: "floating-point or interface values"},
+		{name: "WithMap", wantErr: "floating-point or interface values"},
+		{name: "WithSlice", wantErr: "floating-point or interface values"},
+		{name: "WithDeepEqual", wantErr: "reflect.DeepEqual"},
+		{name: "Fine"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := HashCode(tt.name, nil, structFields(t, typeSpec(t, file, tt.name)), Arguments{}, equalsTypes, nil, info, nil)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("HashCode() error = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("HashCode() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnumSkipsRepeatedValues(t *testing.T) {
+	source := `package main
+
+type Level int
+
+const (
+	Low Level = iota
+	Medium
+	High
+)
+
+const Default Level = Low
+`
+	file, _, info := checkSource(t, source)
+
+	constants := make([]*ast.ValueSpec, 0)
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.CONST {
+			for _, spec := range genDecl.Specs {
+				constants = append(constants, spec.(*ast.ValueSpec))
+			}
+		}
+	}
+
+	enum, err := Enum("Level", typeSpec(t, file, "Level").Type, constants, Arguments{}, info, nil)
+	if err != nil {
+		t.Fatalf("Enum() error = %v", err)
+	}
+
+	main := `
+func main() {
+	fmt.Println(LevelValues(), Default)
+}
+`
+	if output := runGenerated(t, source, enum, main, "fmt"); output != "[Low Medium High] Low" {
+		t.Errorf("generated Enum output = %q, want %q", output, "[Low Medium High] Low")
+	}
+}
+
+func TestWithCopiesDeeply(t *testing.T) {
+	source := `package main
+
+type Item struct {
+	Tags []string
+}
+
+type Config struct {
+	Name   string
+	Groups map[string][]int
+	Matrix [][]int
+	Items  []*Item
+}
+`
+	file, pkg, info := checkSource(t, source)
+
+	with, imports, err := With("Config", nil, structFields(t, typeSpec(t, file, "Config")), Arguments{}, nil, pkg, nil, info, nil)
+	if err != nil {
+		t.Fatalf("With() error = %v", err)
+	}
+	if len(imports) != 0 {
+		t.Errorf("With() imports = %v, want none", imports)
+	}
+
+	main := `
+func main() {
+	original := Config{
+		Groups: map[string][]int{"a": {1}},
+		Matrix: [][]int{{1}},
+		Items:  []*Item{{Tags: []string{"a"}}},
+	}
+
+	copied := original.WithName("copied")
+	copied.Groups["a"][0] = 2
+	copied.Matrix[0][0] = 2
+	copied.Items[0].Tags = nil
+
+	fmt.Println(original.Groups["a"][0], original.Matrix[0][0], original.Items[0].Tags)
+}
+`
+	if output := runGenerated(t, source, with, main, "fmt"); output != "1 1 [a]" {
+		t.Errorf("original was modified through the copy: %q", output)
+	}
+}
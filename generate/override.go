@@ -0,0 +1,99 @@
+package generate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	stringpkg "github.com/YangTaeyoung/gombok/strings"
+)
+
+// TemplateExt 는 템플릿 디렉토리에서 읽는 템플릿 파일의 확장자입니다.
+const TemplateExt = ".tmpl"
+
+// builtinTemplates 는 템플릿 이름별 기본 템플릿입니다. 템플릿 디렉토리의 <name>.tmpl 파일로 바꿀 수 있습니다.
+var builtinTemplates = map[string]string{
+	"allArgsConstructor":      allArgsConstructorTemplate,
+	"requiredArgsConstructor": requiredArgsConstructorTmpl,
+	"noArgsConstructor":       noArgsConstructorTemplate,
+	"builder":                 builderTemplate,
+	"toString":                toStringTemplate,
+	"equals":                  equalsTemplate,
+	"hashCode":                hashCodeTemplate,
+	"getter":                  getterTemplate,
+	"setter":                  setterTemplate,
+	"with":                    withTemplate,
+	"options":                 optionsTemplate,
+	"enum":                    enumTemplate,
+	"clone":                   cloneTemplate,
+	"mapper":                  mapperTemplate,
+	"delegate":                delegateTemplate,
+}
+
+// templateFuncs 는 모든 템플릿에서 사용할 수 있는 함수입니다.
+var templateFuncs = template.FuncMap{
+	"LowerCamelCase": stringpkg.LowerCamel,
+	"UpperCamelCase": stringpkg.UpperCamel,
+	"ReceiverName":   stringpkg.ReceiverName,
+}
+
+// Templates 는 템플릿 이름별로 기본 템플릿 대신 사용할 템플릿입니다. nil 이라면 모든 기본 템플릿을 사용합니다.
+type Templates map[string]string
+
+// LoadTemplates 는 디렉토리의 <name>.tmpl 파일을 읽습니다. 예) builder.tmpl 은 @Builder 의 템플릿을 바꿉니다.
+// 알 수 없는 이름의 템플릿이나 문법 오류가 있는 템플릿은 오류를 반환합니다.
+func LoadTemplates(dir string) (Templates, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading templates: %w", err)
+	}
+
+	templates := make(Templates)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != TemplateExt {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), TemplateExt)
+		if _, ok := builtinTemplates[name]; !ok {
+			return nil, fmt.Errorf("unknown template %s, must be one of %s", entry.Name(), strings.Join(TemplateNames(), ", "))
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading template %s: %w", entry.Name(), err)
+		}
+
+		if _, err = template.New(name).Funcs(templateFuncs).Parse(string(content)); err != nil {
+			return nil, fmt.Errorf("parsing template %s: %w", entry.Name(), err)
+		}
+
+		templates[name] = string(content)
+	}
+
+	return templates, nil
+}
+
+// TemplateNames 는 바꿀 수 있는 템플릿의 이름을 정렬하여 반환합니다.
+func TemplateNames() []string {
+	names := make([]string, 0, len(builtinTemplates))
+	for name := range builtinTemplates {
+		names = append(names, name+TemplateExt)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// parse 는 이름에 해당하는 템플릿을 파싱합니다. 바꾼 템플릿이 없다면 기본 템플릿을 사용합니다.
+func (t Templates) parse(name string) (*template.Template, error) {
+	text, ok := t[name]
+	if !ok {
+		text = builtinTemplates[name]
+	}
+
+	return template.New(name).Funcs(templateFuncs).Parse(text)
+}
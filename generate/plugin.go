@@ -0,0 +1,111 @@
+package generate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// Plugin 은 설정 파일의 plugins 디렉토리에서 manifest 와 템플릿으로 정의한 어노테이션입니다. 예) @Repository
+type Plugin struct {
+	// Annotation 은 @ 를 제외한 어노테이션 이름입니다.
+	Annotation string
+	// Arguments 는 어노테이션에서 사용할 수 있는 인자입니다.
+	Arguments map[string]ArgumentKind
+	// Tags 는 템플릿에 전달할 필드의 struct tag 키입니다. 예) db, json
+	Tags []string
+	// Imports 는 템플릿이 참조할 수 있는 패키지의 import 경로입니다. 생성된 코드가 참조하는 패키지만 import 됩니다.
+	Imports []string
+	// Template 은 코드를 생성하는 템플릿입니다.
+	Template string
+}
+
+// PluginField 는 플러그인 템플릿에 전달되는 필드입니다. Tags 는 manifest 에 지정한 struct tag 키별 값이며, 태그가 없다면 포함되지 않습니다.
+type PluginField struct {
+	Field
+	Tags map[string]string
+}
+
+// PluginFields 는 플러그인 템플릿에 전달되는 데이터입니다. Args 는 어노테이션에 지정한 인자입니다.
+// Fields 는 StructFields 의 Fields 대신 struct tag 를 포함한 필드를 전달합니다.
+type PluginFields struct {
+	StructFields
+	Fields []PluginField
+	Args   Arguments
+}
+
+// NewPlugin 은 어노테이션 이름과 템플릿을 확인하여 Plugin 을 만듭니다.
+func NewPlugin(annotation string, arguments map[string]ArgumentKind, tags []string, imports []string, text string) (Plugin, error) {
+	if !token.IsIdentifier(annotation) {
+		return Plugin{}, fmt.Errorf("invalid annotation name %q", annotation)
+	}
+
+	plugin := Plugin{Annotation: annotation, Arguments: arguments, Tags: tags, Imports: imports, Template: text}
+	if _, err := plugin.parse(); err != nil {
+		return Plugin{}, fmt.Errorf("parsing template of @%s: %w", annotation, err)
+	}
+
+	return plugin, nil
+}
+
+// Generate 는 어노테이션이 지정된 구조체에 대해 플러그인 템플릿으로 코드를 생성합니다.
+func (p Plugin) Generate(name string, typeParams *ast.FieldList, fields []*ast.Field, args Arguments, info *types.Info) (string, error) {
+	if err := args.Validate(p.Annotation, p.Arguments); err != nil {
+		return "", err
+	}
+
+	allFields := make([]PluginField, 0)
+	for _, field := range fields {
+		tags := make(map[string]string)
+		if field.Tag != nil {
+			tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+			for _, key := range p.Tags {
+				if value, exists := tag.Lookup(key); exists {
+					tags[key] = value
+				}
+			}
+		}
+
+		// embedded 필드
+		if field.Names == nil {
+			allFields = append(allFields, PluginField{Field: newField(exprToString(field.Type), field.Type, info), Tags: tags})
+			continue
+		}
+
+		// 일반 필드
+		for _, fieldName := range field.Names {
+			allFields = append(allFields, PluginField{Field: newField(fieldName.Name, field.Type, info), Tags: tags})
+		}
+	}
+
+	tmpl, err := p.parse()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, PluginFields{
+		StructFields: StructFields{
+			StructName: name,
+			TypeParams: typeParamList(typeParams),
+			TypeArgs:   typeArgList(typeParams),
+		},
+		Fields: allFields,
+		Args:   args,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// parse 는 플러그인 템플릿을 파싱합니다. 기본 템플릿과 같은 함수를 사용할 수 있습니다.
+func (p Plugin) parse() (*template.Template, error) {
+	return template.New(p.Annotation).Funcs(templateFuncs).Parse(p.Template)
+}
@@ -2,9 +2,9 @@ package generate
 
 // 생성자 함수를 만들기 위한 템플릿을 정의합니다.
 var requiredArgsConstructorTmpl = `
-// New{{ if not .DefaultConstructor }}{{.StructName}}WithRequiredArgs{{end}}
-func New{{ if not .DefaultConstructor }}{{.StructName}}WithRequiredArgs{{end}}({{range $index, $element := .Fields}}{{if $index}}, {{end}}{{LowerCamelCase $element.Name}} {{$element.Type}}{{end}}) {{.StructName}} {
-    return {{.StructName}}{
+// {{.ConstructorName}}
+func {{.ConstructorName}}{{.TypeParams}}({{range $index, $element := .Fields}}{{if $index}}, {{end}}{{LowerCamelCase $element.Name}} {{$element.Type}}{{end}}) {{.StructName}}{{.TypeArgs}} {
+    return {{.StructName}}{{.TypeArgs}}{
 		{{range .Fields}}{{.Name}}: {{LowerCamelCase .Name}},
 		{{end}}
     }
@@ -13,9 +13,9 @@ func New{{ if not .DefaultConstructor }}{{.StructName}}WithRequiredArgs{{end}}({
 
 // 생성자 함수를 만들기 위한 템플릿을 정의합니다.
 var allArgsConstructorTemplate = `
-// New{{ if not .DefaultConstructor }}{{.StructName}}WithAllArgs{{end}}
-func New{{ if not .DefaultConstructor }}{{.StructName}}WithAllArgs{{end}}({{range $index, $element := .Fields}}{{if $index}}, {{end}}{{LowerCamelCase $element.Name}} {{$element.Type}}{{end}}) {{.StructName}} {
-    return {{.StructName}}{
+// {{.ConstructorName}}
+func {{.ConstructorName}}{{.TypeParams}}({{range $index, $element := .Fields}}{{if $index}}, {{end}}{{LowerCamelCase $element.Name}} {{$element.Type}}{{end}}) {{.StructName}}{{.TypeArgs}} {
+    return {{.StructName}}{{.TypeArgs}}{
         {{range .Fields}}{{.Name}}: {{LowerCamelCase .Name}},
 		{{end}}
     }
@@ -23,9 +23,9 @@ func New{{ if not .DefaultConstructor }}{{.StructName}}WithAllArgs{{end}}({{rang
 `
 
 var noArgsConstructorTemplate = `
-// New{{ if not .DefaultConstructor }}{{.StructName}}WithNoArgs{{end}}
-func New{{ if not .DefaultConstructor }}{{.StructName}}WithNoArgs{{end}}() {{.StructName}} {
-    return {{.StructName}}{}
+// {{.ConstructorName}}
+func {{.ConstructorName}}{{.TypeParams}}() {{.StructName}}{{.TypeArgs}} {
+    return {{.StructName}}{{.TypeArgs}}{}
 }
 `
 
@@ -33,17 +33,19 @@ func New{{ if not .DefaultConstructor }}{{.StructName}}WithNoArgs{{end}}() {{.St
 var builderTemplate = `
 // {{.StructName}}Builder
 // a builder for {{.StructName}}
-type {{.StructName}}Builder struct {
-    target *{{.StructName}}
+type {{.StructName}}Builder{{.TypeParams}} struct {
+    target *{{.StructName}}{{.TypeArgs}}
 }
 
 {{range .Fields}}
-// With{{.Name}}
+// {{$.Prefix}}{{.Name}}
 // sets the {{.Name}} field of the target {{$.StructName}}
-func ({{ReceiverName $.StructName}}b {{$.StructName}}Builder) With{{.Name}}({{LowerCamelCase .Name}} {{.Type}}) {{$.StructName}}Builder {
-	{{ if .MustBuild }}{{ if .IsPointer }}if {{LowerCamelCase .Name}} == nil {
+func ({{ReceiverName $.StructName}}b {{$.StructName}}Builder{{$.TypeArgs}}) {{$.Prefix}}{{.Name}}({{LowerCamelCase .Name}} {{.Type}}) {{$.StructName}}Builder{{$.TypeArgs}} {
+	{{ if .MustBuild }}{{ if .IsNillable }}if {{LowerCamelCase .Name}} == nil {
 		panic("{{$.StructName}}Builder: {{.Name}} must not be nil")
-	}{{ else }}if reflect.DeepEqual({{LowerCamelCase .Name}}, {{.Type}}{}) {
+	}{{ else if .IsComparable }}if {{LowerCamelCase .Name}} == *new({{.Type}}) {
+		panic("{{$.StructName}}Builder: {{.Name}} must not be empty")
+	}{{ else }}if reflect.DeepEqual({{LowerCamelCase .Name}}, *new({{.Type}})) {
 		panic("{{$.StructName}}Builder: {{.Name}} must not be empty")
 	}{{ end }}{{ end }}
     {{ReceiverName $.StructName}}b.target.{{.Name}} = {{LowerCamelCase .Name}}
@@ -52,37 +54,156 @@ func ({{ReceiverName $.StructName}}b {{$.StructName}}Builder) With{{.Name}}({{Lo
 }
 {{end}}
 
-// Build
+// {{.BuildName}}
 // constructs a {{.StructName}} from the builder
-func ({{ReceiverName $.StructName}}b {{.StructName}}Builder) Build() {{.StructName}} {
+func ({{ReceiverName $.StructName}}b {{.StructName}}Builder{{.TypeArgs}}) {{.BuildName}}() {{.StructName}}{{.TypeArgs}} {
     return *{{ReceiverName $.StructName}}b.target
 }
 
-// New{{.StructName}}Builder
+// {{.ConstructorName}}
 // creates a new builder instance for {{.StructName}}
-func New{{.StructName}}Builder() {{.StructName}}Builder {
-    return {{.StructName}}Builder{target: &{{.StructName}}{}}
+func {{.ConstructorName}}{{.TypeParams}}() {{.StructName}}Builder{{.TypeArgs}} {
+    return {{.StructName}}Builder{{.TypeArgs}}{target: &{{.StructName}}{{.TypeArgs}}{}}
 }
 `
 
 var toStringTemplate = `
 // String
-func ({{ReceiverName $.StructName}} *{{.StructName}}) String() string {
+func ({{ReceiverName $.StructName}} *{{.StructName}}{{.TypeArgs}}) String() string {
 	return fmt.Sprintf("{{.StructName}}{ {{range $index, $element := .Fields}}{{if $index}}, {{end}}{{.Name}}: %v{{end}} }", {{range $index, $element := .Fields}}{{if $index}}, {{end}}{{ReceiverName $.StructName}}.{{.Name}}{{end}})
 }
 `
 
 var equalsTemplate = `
 // Equals
-func ({{ReceiverName $.StructName}} *{{.StructName}}) Equals({{LowerCamelCase .StructName}} {{.StructName}}) bool {
-	return reflect.DeepEqual({{ReceiverName $.StructName}}, {{LowerCamelCase .StructName}})
+func ({{ReceiverName $.StructName}} {{.StructName}}{{.TypeArgs}}) Equals({{LowerCamelCase .StructName}} {{.StructName}}{{.TypeArgs}}) bool {
+	{{- range .Fields}}
+	{{- if .UseDeepEqual}}
+	if !reflect.DeepEqual({{ReceiverName $.StructName}}.{{.Name}}, {{LowerCamelCase $.StructName}}.{{.Name}}) {
+		return false
+	}
+	{{- else if .IsSlice}}
+	if len({{ReceiverName $.StructName}}.{{.Name}}) != len({{LowerCamelCase $.StructName}}.{{.Name}}) {
+		return false
+	}
+	for index := range {{ReceiverName $.StructName}}.{{.Name}} {
+		if {{if .HasEquals}}!{{ReceiverName $.StructName}}.{{.Name}}[index].Equals({{LowerCamelCase $.StructName}}.{{.Name}}[index]){{else}}{{ReceiverName $.StructName}}.{{.Name}}[index] != {{LowerCamelCase $.StructName}}.{{.Name}}[index]{{end}} {
+			return false
+		}
+	}
+	{{- else if .IsMap}}
+	if len({{ReceiverName $.StructName}}.{{.Name}}) != len({{LowerCamelCase $.StructName}}.{{.Name}}) {
+		return false
+	}
+	for entryKey, entryValue := range {{ReceiverName $.StructName}}.{{.Name}} {
+		if other, ok := {{LowerCamelCase $.StructName}}.{{.Name}}[entryKey]; !ok || {{if .HasEquals}}!other.Equals(entryValue){{else}}other != entryValue{{end}} {
+			return false
+		}
+	}
+	{{- else if and .HasEquals .IsPointer}}
+	if ({{ReceiverName $.StructName}}.{{.Name}} == nil) != ({{LowerCamelCase $.StructName}}.{{.Name}} == nil) || ({{ReceiverName $.StructName}}.{{.Name}} != nil && !{{ReceiverName $.StructName}}.{{.Name}}.Equals(*{{LowerCamelCase $.StructName}}.{{.Name}})) {
+		return false
+	}
+	{{- else if .HasEquals}}
+	if !{{ReceiverName $.StructName}}.{{.Name}}.Equals({{LowerCamelCase $.StructName}}.{{.Name}}) {
+		return false
+	}
+	{{- else}}
+	if {{ReceiverName $.StructName}}.{{.Name}} != {{LowerCamelCase $.StructName}}.{{.Name}} {
+		return false
+	}
+	{{- end}}
+	{{- end}}
+
+	return true
+}
+`
+
+var hashCodeTemplate = `
+// Hash
+// returns a deterministic FNV-1a hash of the fields compared by Equals
+func ({{ReceiverName $.StructName}} {{.StructName}}{{.TypeArgs}}) Hash() uint64 {
+	hash := fnv.New64a()
+	{{- range .Fields}}
+	{{- if and .HasHash .IsSlice}}
+	for _, element := range {{ReceiverName $.StructName}}.{{.Name}} {
+		_, _ = fmt.Fprintf(hash, "%d\x00", element.Hash())
+	}
+	{{- else if and .HasHash .IsPointer}}
+	if {{ReceiverName $.StructName}}.{{.Name}} != nil {
+		_, _ = fmt.Fprintf(hash, "%d\x00", {{ReceiverName $.StructName}}.{{.Name}}.Hash())
+	}
+	{{- else if and .HasHash .IsMap}}
+	var {{LowerCamelCase .Name}}Hash uint64
+	for entryKey, entryValue := range {{ReceiverName $.StructName}}.{{.Name}} {
+		entryHash := fnv.New64a()
+		_, _ = fmt.Fprintf(entryHash, "%v\x00%d", entryKey, entryValue.Hash())
+		{{LowerCamelCase .Name}}Hash += entryHash.Sum64()
+	}
+	_, _ = fmt.Fprintf(hash, "%d\x00", {{LowerCamelCase .Name}}Hash)
+	{{- else if .HasHash}}
+	_, _ = fmt.Fprintf(hash, "%d\x00", {{ReceiverName $.StructName}}.{{.Name}}.Hash())
+	{{- else if and .IsSlice (or .IsFloat .IsComplex .IsDynamic)}}
+	for _, element := range {{ReceiverName $.StructName}}.{{.Name}} {
+		{{- if .IsFloat}}
+		if element == 0 {
+			element = 0
+		}
+		_, _ = fmt.Fprintf(hash, "%v\x00", element)
+		{{- else if .IsComplex}}
+		realPart, imagPart := real(element), imag(element)
+		if realPart == 0 {
+			realPart = 0
+		}
+		if imagPart == 0 {
+			imagPart = 0
+		}
+		_, _ = fmt.Fprintf(hash, "%v\x00%v\x00", realPart, imagPart)
+		{{- else}}
+		switch value := any(element).(type) {
+		case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+			_, _ = fmt.Fprintf(hash, "%T\x00%v\x00", value, value)
+		default:
+			_, _ = fmt.Fprintf(hash, "%T\x00", value)
+		}
+		{{- end}}
+	}
+	{{- else if .IsFloat}}
+	{{LowerCamelCase .Name}}Value := {{ReceiverName $.StructName}}.{{.Name}}
+	if {{LowerCamelCase .Name}}Value == 0 {
+		{{LowerCamelCase .Name}}Value = 0
+	}
+	_, _ = fmt.Fprintf(hash, "%v\x00", {{LowerCamelCase .Name}}Value)
+	{{- else if .IsComplex}}
+	{{LowerCamelCase .Name}}Real, {{LowerCamelCase .Name}}Imag := real({{ReceiverName $.StructName}}.{{.Name}}), imag({{ReceiverName $.StructName}}.{{.Name}})
+	if {{LowerCamelCase .Name}}Real == 0 {
+		{{LowerCamelCase .Name}}Real = 0
+	}
+	if {{LowerCamelCase .Name}}Imag == 0 {
+		{{LowerCamelCase .Name}}Imag = 0
+	}
+	_, _ = fmt.Fprintf(hash, "%v\x00%v\x00", {{LowerCamelCase .Name}}Real, {{LowerCamelCase .Name}}Imag)
+	{{- else if .IsDynamic}}
+	// values that may hold floating-point numbers can be equal but print differently, so only their type is hashed
+	switch value := any({{ReceiverName $.StructName}}.{{.Name}}).(type) {
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+		_, _ = fmt.Fprintf(hash, "%T\x00%v\x00", value, value)
+	default:
+		_, _ = fmt.Fprintf(hash, "%T\x00", value)
+	}
+	{{- else}}
+	_, _ = fmt.Fprintf(hash, "%v\x00", {{ReceiverName $.StructName}}.{{.Name}})
+	{{- end}}
+	{{- end}}
+
+	return hash.Sum64()
 }
 `
 
 var getterTemplate = `
 {{range .Fields}}
-// Get{{.Name}}
-func ({{ReceiverName $.StructName}} *{{$.StructName}}) Get{{.Name}}() {{.Type}} {
+// {{$.Prefix}}{{UpperCamelCase .Name}}
+func ({{ReceiverName $.StructName}} {{if not $.ValueReceiver}}*{{end}}{{$.StructName}}{{$.TypeArgs}}) {{$.Prefix}}{{UpperCamelCase .Name}}() {{.Type}} {
 	return {{ReceiverName $.StructName}}.{{.Name}}
 }
 {{end}}
@@ -90,9 +211,171 @@ func ({{ReceiverName $.StructName}} *{{$.StructName}}) Get{{.Name}}() {{.Type}}
 
 var setterTemplate = `
 {{range .Fields}}
-// Set{{.Name}}
-func ({{ReceiverName $.StructName}} *{{$.StructName}}) Set{{.Name}}({{LowerCamelCase .Name}} {{.Type}}) {
+// {{$.Prefix}}{{.Name}}
+func ({{ReceiverName $.StructName}} *{{$.StructName}}{{$.TypeArgs}}) {{$.Prefix}}{{.Name}}({{LowerCamelCase .Name}} {{.Type}}) {
 	{{ReceiverName $.StructName}}.{{.Name}} = {{LowerCamelCase .Name}}
 }
 {{end}}
 `
+
+var withTemplate = `
+{{range .Fields}}
+// {{$.Prefix}}{{UpperCamelCase .Name}}
+// returns a copy of the {{$.StructName}} with the {{.Name}} field replaced
+func ({{ReceiverName $.StructName}} {{$.StructName}}{{$.TypeArgs}}) {{$.Prefix}}{{UpperCamelCase .Name}}({{LowerCamelCase .Name}} {{.Type}}) {{$.StructName}}{{$.TypeArgs}} {
+	{{ReceiverName $.StructName}}.{{.Name}} = {{LowerCamelCase .Name}}
+	{{range $.Statements}}
+	{{.}}
+	{{- end}}
+
+	return {{ReceiverName $.StructName}}
+}
+{{end}}
+`
+
+// Functional Options 패턴을 위한 템플릿을 정의합니다.
+var optionsTemplate = `
+// {{.StructName}}Option
+// configures a {{.StructName}} created by {{.ConstructorName}}
+type {{.StructName}}Option{{.TypeParams}} func(*{{.StructName}}{{.TypeArgs}})
+
+{{range .Fields}}
+// {{$.Prefix}}{{UpperCamelCase .Name}}
+// sets the {{.Name}} field of the {{$.StructName}}
+func {{$.Prefix}}{{UpperCamelCase .Name}}{{$.TypeParams}}({{LowerCamelCase .Name}} {{.Type}}) {{$.StructName}}Option{{$.TypeArgs}} {
+	return func({{ReceiverName $.StructName}} *{{$.StructName}}{{$.TypeArgs}}) {
+		{{ReceiverName $.StructName}}.{{.Name}} = {{LowerCamelCase .Name}}
+	}
+}
+{{end}}
+
+// {{.ConstructorName}}
+// creates a new {{.StructName}} from the required fields and applies the options
+func {{.ConstructorName}}{{.TypeParams}}({{range .RequiredFields}}{{LowerCamelCase .Name}} {{.Type}}, {{end}}opts ...{{.StructName}}Option{{.TypeArgs}}) {{.StructName}}{{.TypeArgs}} {
+	{{ReceiverName .StructName}} := {{.StructName}}{{.TypeArgs}}{
+		{{range .RequiredFields}}{{.Name}}: {{LowerCamelCase .Name}},
+		{{end}}
+	}
+
+	for _, opt := range opts {
+		opt(&{{ReceiverName .StructName}})
+	}
+
+	return {{ReceiverName .StructName}}
+}
+`
+
+// 열거형 타입을 위한 템플릿을 정의합니다.
+var enumTemplate = `
+// String
+func ({{ReceiverName .TypeName}} {{.TypeName}}) String() string {
+	switch {{ReceiverName .TypeName}} {
+	{{- range .Constants}}
+	case {{.Name}}:
+		return {{printf "%q" .Value}}
+	{{- end}}
+	}
+
+	return fmt.Sprintf("{{.TypeName}}(%v)", {{.UnderlyingType}}({{ReceiverName .TypeName}}))
+}
+
+// Parse{{.TypeName}}
+// returns the {{.TypeName}} whose string name is name
+func Parse{{.TypeName}}(name string) ({{.TypeName}}, error) {
+	switch name {
+	{{- range .Constants}}
+	case {{printf "%q" .Value}}:
+		return {{.Name}}, nil
+	{{- end}}
+	}
+
+	var zero {{.TypeName}}
+	return zero, fmt.Errorf("invalid {{.TypeName}}: %q", name)
+}
+
+// {{.TypeName}}Values
+// returns all values of {{.TypeName}} in declaration order
+func {{.TypeName}}Values() []{{.TypeName}} {
+	return []{{.TypeName}}{
+		{{- range .Constants}}
+		{{.Name}},
+		{{- end}}
+	}
+}
+
+// IsValid
+// reports whether the value is one of the declared {{.TypeName}} constants
+func ({{ReceiverName .TypeName}} {{.TypeName}}) IsValid() bool {
+	switch {{ReceiverName .TypeName}} {
+	case {{range $index, $element := .Constants}}{{if $index}}, {{end}}{{.Name}}{{end}}:
+		return true
+	}
+
+	return false
+}
+
+// MarshalText
+func ({{ReceiverName .TypeName}} {{.TypeName}}) MarshalText() ([]byte, error) {
+	if !{{ReceiverName .TypeName}}.IsValid() {
+		return nil, fmt.Errorf("invalid {{.TypeName}}: %v", {{.UnderlyingType}}({{ReceiverName .TypeName}}))
+	}
+
+	return []byte({{ReceiverName .TypeName}}.String()), nil
+}
+
+// UnmarshalText
+func ({{ReceiverName .TypeName}} *{{.TypeName}}) UnmarshalText(text []byte) error {
+	parsed, err := Parse{{.TypeName}}(string(text))
+	if err != nil {
+		return err
+	}
+
+	*{{ReceiverName .TypeName}} = parsed
+
+	return nil
+}
+`
+
+var cloneTemplate = `
+// Clone
+// returns a deep copy of the {{.StructName}}
+func ({{ReceiverName .StructName}} {{.StructName}}{{.TypeArgs}}) Clone() {{.StructName}}{{.TypeArgs}} {
+	clone := {{ReceiverName .StructName}}
+	{{range .Statements}}
+	{{.}}
+	{{- end}}
+
+	return clone
+}
+`
+
+// 구조체 간 변환을 위한 템플릿을 정의합니다.
+var mapperTemplate = `
+// To{{.TargetName}}
+// converts the {{.StructName}} to a {{.TargetName}}
+func ({{ReceiverName .StructName}} {{.StructName}}{{.TypeArgs}}) To{{.TargetName}}() {{.TargetName}}{{.TargetTypeArgs}} {
+	return {{.TargetName}}{{.TargetTypeArgs}}{
+		{{range .Fields}}{{.MappedName}}: {{ReceiverName $.StructName}}.{{.Name}},
+		{{end}}
+	}
+}
+
+// New{{.StructName}}From{{.TargetName}}
+// creates a {{.StructName}} from a {{.TargetName}}
+func New{{.StructName}}From{{.TargetName}}{{.TypeParams}}({{LowerCamelCase .TargetName}} {{.TargetName}}{{.TargetTypeArgs}}) {{.StructName}}{{.TypeArgs}} {
+	return {{.StructName}}{{.TypeArgs}}{
+		{{range .Fields}}{{.Name}}: {{LowerCamelCase $.TargetName}}.{{.MappedName}},
+		{{end}}
+	}
+}
+`
+
+var delegateTemplate = `
+{{range .Methods}}
+// {{.Name}}
+// delegates to the {{.Field}} field of the {{$.StructName}}
+func ({{ReceiverName $.StructName}} {{if .PointerReceiver}}*{{end}}{{$.StructName}}{{$.TypeArgs}}) {{.Name}}({{.Params}}) {{.Results}} {
+	{{if .Results}}return {{end}}{{ReceiverName $.StructName}}.{{.Field}}.{{.Name}}({{.Args}})
+}
+{{end}}
+`
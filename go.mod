@@ -3,13 +3,16 @@ module github.com/YangTaeyoung/gombok
 go 1.21.1
 
 require (
-	github.com/iancoleman/strcase v0.3.0
-	github.com/stoewer/go-strcase v1.3.0
+	github.com/aymanbagabas/go-udiff v0.2.0
 	github.com/urfave/cli/v2 v2.25.7
+	golang.org/x/tools v0.24.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/cpuguy83/go-md2man/v2 v2.0.2 // indirect
 	github.com/russross/blackfriday/v2 v2.1.0 // indirect
 	github.com/xrash/smetrics v0.0.0-20201216005158-039620a65673 // indirect
+	golang.org/x/mod v0.20.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
 )
@@ -9,8 +9,41 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-func GombokAction(_ *cli.Context) error {
-	parser.Run()
+// options 는 플래그와 인자로 코드를 생성할 범위와 출력 방식을 만듭니다.
+func options(c *cli.Context) parser.Options {
+	return parser.Options{
+		DryRun: c.Bool("dry-run"),
+		Stdout: c.String("stdout"),
+		Filter: parser.Filter{
+			Exclude: c.StringSlice("exclude"),
+			Include: c.StringSlice("include"),
+		},
+		Patterns: c.Args().Slice(),
+	}
+}
+
+func GombokAction(c *cli.Context) error {
+	if err := parser.Run(options(c)); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	return nil
+}
+
+// CheckAction 은 생성된 파일이 최신 상태인지 확인하고, 최신 상태가 아니라면 0 이 아닌 코드로 종료합니다.
+func CheckAction(c *cli.Context) error {
+	if err := parser.Check(options(c)); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	return nil
+}
+
+// CleanAction 은 gombok 이 생성한 모든 파일을 삭제합니다.
+func CleanAction(c *cli.Context) error {
+	if err := parser.Clean(options(c)); err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
 
 	return nil
 }
@@ -20,7 +53,40 @@ func main() {
 	app.Name = "gombok"
 	app.Usage = "Gombok is Lombok Style Code Generator for Go"
 	app.Version = "1.0.0"
+	app.ArgsUsage = "[packages | files.go]"
 	app.Action = GombokAction
+	app.Flags = []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "List the files that would be created, changed or deleted without writing them",
+		},
+		&cli.StringFlag{
+			Name:  "stdout",
+			Usage: "Print the code generated for a single source `FILE.go or TYPE` instead of writing files",
+		},
+		&cli.StringSliceFlag{
+			Name:  "exclude",
+			Usage: "Skip source files or directories matching the `GLOB`, relative to the working directory",
+		},
+		&cli.StringSliceFlag{
+			Name:  "include",
+			Usage: "Only use source files or directories matching the `GLOB`, relative to the working directory",
+		},
+	}
+	app.Commands = []*cli.Command{
+		{
+			Name:      "check",
+			ArgsUsage: "[packages | files.go]",
+			Usage:     "Verify that generated files are up to date without writing them",
+			Action:    CheckAction,
+		},
+		{
+			Name:      "clean",
+			ArgsUsage: "[packages | files.go]",
+			Usage:     "Remove all files generated by gombok",
+			Action:    CleanAction,
+		},
+	}
 
 	if err := app.Run(os.Args); err != nil {
 		log.Panicf("gombok error: %v", err)
@@ -0,0 +1,471 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YangTaeyoung/gombok/config"
+	"github.com/YangTaeyoung/gombok/generate"
+)
+
+// annotations 는 gombok이 인식하는 어노테이션을 코드 생성 순서대로 나열합니다.
+var annotations = []string{
+	"AllArgsConstructor",
+	"RequiredArgsConstructor",
+	"NoArgsConstructor",
+	"Builder",
+	"ToString",
+	"Equals",
+	"HashCode",
+	"Getter",
+	"Setter",
+	"With",
+	"Options",
+	"Enum",
+	"Clone",
+	"Mapper",
+	"Delegate",
+}
+
+// compositeAnnotations 는 여러 어노테이션으로 펼쳐지는 합성 어노테이션을 정의합니다.
+var compositeAnnotations = map[string][]string{
+	"Data":  {"Getter", "Setter", "ToString", "Equals", "RequiredArgsConstructor"},
+	"Value": {"AllArgsConstructor", "Getter", "ToString", "Equals"},
+	// Hash 와 Equals 가 항상 같은 필드를 비교하도록 @HashCode 는 @Equals 를 함께 생성합니다.
+	"HashCode": {"Equals"},
+}
+
+// mapperArguments 는 @Mapper 에서 사용할 수 있는 인자입니다. to 는 변환 대상 타입이며 여러 개를 지정할 수 있습니다.
+var mapperArguments = map[string]generate.ArgumentKind{
+	"to": generate.ListArgument,
+}
+
+// annotation 은 주석에서 찾은 어노테이션과 인자입니다. pos 는 주석에서 @ 의 위치입니다.
+type annotation struct {
+	name string
+	args generate.Arguments
+	pos  token.Pos
+}
+
+// isAnnotation 은 gombok이 인식하는 어노테이션인지 확인합니다.
+func isAnnotation(name string) bool {
+	if _, ok := compositeAnnotations[name]; ok {
+		return true
+	}
+
+	for _, known := range annotations {
+		if known == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isKnown 은 gombok이 인식하는 어노테이션이거나 설정 파일에서 정의한 플러그인 어노테이션인지 확인합니다.
+func isKnown(name string, plugins map[string]generate.Plugin) bool {
+	_, isPlugin := plugins[name]
+	return isPlugin || isAnnotation(name)
+}
+
+// lexAnnotations 는 주석의 각 줄 시작에 있는 어노테이션을 순서대로 읽습니다. 인식하지 못하는 어노테이션도 포함됩니다.
+// 한 줄에 공백으로 구분된 여러 어노테이션을 지정할 수 있으며, 어노테이션이 아닌 단어가 나오면 해당 줄의 나머지는 설명으로 봅니다.
+// 따라서 "don't add @Getter here" 와 같은 설명 안의 어노테이션은 무시됩니다.
+// 인자는 @Builder(prefix="Set", build=Finish) 와 같이 괄호 안에 이름=값 형식으로 지정하며,
+// 값은 문자열, true/false, [a, b] 형식의 목록입니다. @Builder.Default 는 @Builder(default=true) 와 같습니다.
+// plugins 는 설정 파일에서 정의한 어노테이션으로, 인자를 함께 읽습니다.
+func lexAnnotations(comment *ast.Comment, plugins map[string]generate.Plugin) ([]annotation, error) {
+	text := comment.Text
+	isBlock := strings.HasPrefix(text, "/*")
+	if isBlock {
+		text = strings.TrimSuffix(text, "*/")
+	}
+
+	found := make([]annotation, 0)
+	for pos := 0; pos < len(text); {
+		// 주석 기호와 블록 주석 줄 앞의 * 를 건너뜁니다.
+		if pos == 0 {
+			pos = 2
+		}
+		pos = skipBlanks(text, pos)
+		if isBlock && pos < len(text) && text[pos] == '*' {
+			pos = skipBlanks(text, pos+1)
+		}
+
+		for pos < len(text) && text[pos] == '@' {
+			name, next := readIdentifier(text, pos+1)
+			if name == "" {
+				break
+			}
+
+			found = append(found, annotation{name: name, args: make(generate.Arguments), pos: comment.Slash + token.Pos(pos)})
+			if !isKnown(name, plugins) {
+				pos = skipBlanks(text, next)
+				continue
+			}
+
+			current := &found[len(found)-1]
+			switch rest := text[next:]; {
+			case strings.HasPrefix(rest, "("):
+				args, length, err := parseArguments(rest)
+				if err != nil {
+					return nil, fmt.Errorf("invalid arguments of @%s: %w", name, err)
+				}
+
+				current.args = args
+				next += length
+			case strings.HasPrefix(rest, ".Default"):
+				current.args["default"] = true
+				next += len(".Default")
+			}
+
+			pos = skipBlanks(text, next)
+		}
+
+		newline := strings.IndexByte(text[pos:], '\n')
+		if newline < 0 {
+			break
+		}
+		pos += newline + 1
+	}
+
+	return found, nil
+}
+
+// suggestAnnotation 은 인식하지 못하는 어노테이션과 가장 비슷한 어노테이션을 반환합니다. 비슷한 어노테이션이 없다면 빈 문자열을 반환합니다.
+func suggestAnnotation(name string, plugins map[string]generate.Plugin) string {
+	candidates := append([]string{"Data", "Value"}, annotations...)
+	for plugin := range plugins {
+		candidates = append(candidates, plugin)
+	}
+	// 편집 거리가 같은 후보가 여러 개일 때 항상 같은 후보를 제안하도록 정렬합니다.
+	sort.Strings(candidates[len(annotations)+2:])
+
+	var (
+		suggestion string
+		best       = 3
+	)
+	for _, candidate := range candidates {
+		if distance := editDistance(strings.ToLower(name), strings.ToLower(candidate)); distance < best {
+			suggestion, best = candidate, distance
+		}
+	}
+
+	return suggestion
+}
+
+// editDistance 는 두 문자열의 편집 거리를 계산합니다.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
+
+// annotationWarnings 는 주석에서 인식하지 못하는 어노테이션을 file:line 위치와 함께 경고 메시지로 반환합니다.
+func annotationWarnings(fset *token.FileSet, doc *ast.CommentGroup, plugins map[string]generate.Plugin) []string {
+	warnings := make([]string, 0)
+	if doc == nil {
+		return warnings
+	}
+
+	for _, comment := range doc.List {
+		// 인자의 오류는 collectAnnotations 에서 보고합니다.
+		lexed, err := lexAnnotations(comment, plugins)
+		if err != nil {
+			continue
+		}
+
+		for _, annotation := range lexed {
+			if isKnown(annotation.name, plugins) {
+				continue
+			}
+
+			position := fset.Position(annotation.pos)
+			warning := fmt.Sprintf("%s:%d: unknown annotation @%s", position.Filename, position.Line, annotation.name)
+			if suggestion := suggestAnnotation(annotation.name, plugins); suggestion != "" {
+				warning += fmt.Sprintf(", did you mean @%s?", suggestion)
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings
+}
+
+// parseArguments 는 괄호로 감싼 어노테이션 인자를 읽고, 닫는 괄호까지 읽은 길이를 반환합니다.
+func parseArguments(text string) (generate.Arguments, int, error) {
+	args := make(generate.Arguments)
+
+	pos := skipSpaces(text, 1)
+	if pos < len(text) && text[pos] == ')' {
+		return args, pos + 1, nil
+	}
+
+	for {
+		key, next := readWord(text, pos)
+		if key == "" {
+			return nil, 0, fmt.Errorf("expected argument name at %q", text[pos:])
+		}
+
+		pos = skipSpaces(text, next)
+		if pos >= len(text) || text[pos] != '=' {
+			return nil, 0, fmt.Errorf("expected = after argument %s", key)
+		}
+
+		value, next, err := parseValue(text, skipSpaces(text, pos+1))
+		if err != nil {
+			return nil, 0, fmt.Errorf("argument %s: %w", key, err)
+		}
+
+		if _, exists := args[key]; exists {
+			return nil, 0, fmt.Errorf("argument %s is specified more than once", key)
+		}
+		args[key] = value
+
+		pos = skipSpaces(text, next)
+		if pos >= len(text) {
+			return nil, 0, fmt.Errorf("missing closing parenthesis")
+		}
+
+		switch text[pos] {
+		case ',':
+			pos = skipSpaces(text, pos+1)
+		case ')':
+			return args, pos + 1, nil
+		default:
+			return nil, 0, fmt.Errorf("unexpected %q after argument %s", text[pos], key)
+		}
+	}
+}
+
+// parseValue 는 문자열, bool, 목록 중 하나의 인자 값을 읽고 다음 위치를 반환합니다.
+// 따옴표 없는 단어는 true, false 가 아니라면 문자열로 읽습니다.
+func parseValue(text string, pos int) (any, int, error) {
+	if pos >= len(text) {
+		return nil, 0, fmt.Errorf("missing value")
+	}
+
+	switch text[pos] {
+	case '"':
+		return readString(text, pos)
+	case '[':
+		list := make([]string, 0)
+		pos = skipSpaces(text, pos+1)
+		if pos < len(text) && text[pos] == ']' {
+			return list, pos + 1, nil
+		}
+
+		for {
+			var (
+				item string
+				err  error
+			)
+			if pos < len(text) && text[pos] == '"' {
+				item, pos, err = readString(text, pos)
+				if err != nil {
+					return nil, 0, err
+				}
+			} else {
+				item, pos = readWord(text, pos)
+				if item == "" {
+					return nil, 0, fmt.Errorf("expected list element")
+				}
+			}
+			list = append(list, item)
+
+			pos = skipSpaces(text, pos)
+			if pos >= len(text) {
+				return nil, 0, fmt.Errorf("missing closing bracket")
+			}
+
+			switch text[pos] {
+			case ',':
+				pos = skipSpaces(text, pos+1)
+			case ']':
+				return list, pos + 1, nil
+			default:
+				return nil, 0, fmt.Errorf("unexpected %q in list", text[pos])
+			}
+		}
+	}
+
+	word, next := readWord(text, pos)
+	switch word {
+	case "":
+		return nil, 0, fmt.Errorf("expected value at %q", text[pos:])
+	case "true":
+		return true, next, nil
+	case "false":
+		return false, next, nil
+	}
+
+	return word, next, nil
+}
+
+// readString 은 따옴표로 감싼 문자열을 읽고 다음 위치를 반환합니다.
+func readString(text string, pos int) (string, int, error) {
+	for end := pos + 1; end < len(text); end++ {
+		switch text[end] {
+		case '\\':
+			end++
+		case '"':
+			value, err := strconv.Unquote(text[pos : end+1])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid string %s", text[pos:end+1])
+			}
+
+			return value, end + 1, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("missing closing quote")
+}
+
+// readIdentifier 는 식별자를 읽고 다음 위치를 반환합니다.
+func readIdentifier(text string, pos int) (string, int) {
+	end := pos
+	for end < len(text) {
+		c := text[end]
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		end++
+	}
+
+	return text[pos:end], end
+}
+
+// readWord 는 식별자로 사용할 수 있는 문자와 . 으로 이루어진 단어를 읽고 다음 위치를 반환합니다.
+func readWord(text string, pos int) (string, int) {
+	end := pos
+	for end < len(text) {
+		c := text[end]
+		if c != '_' && c != '.' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		end++
+	}
+
+	return text[pos:end], end
+}
+
+// skipBlanks 는 줄바꿈을 제외한 공백을 건너뛴 위치를 반환합니다.
+func skipBlanks(text string, pos int) int {
+	for pos < len(text) && (text[pos] == ' ' || text[pos] == '\t') {
+		pos++
+	}
+
+	return pos
+}
+
+// skipSpaces 는 공백을 건너뛴 위치를 반환합니다.
+func skipSpaces(text string, pos int) int {
+	for pos < len(text) && (text[pos] == ' ' || text[pos] == '\t' || text[pos] == '\n' || text[pos] == '\r') {
+		pos++
+	}
+
+	return pos
+}
+
+// collectAnnotations 는 주석에서 어노테이션을 찾아 어노테이션 이름별 인자를 반환합니다.
+// 같은 어노테이션이 여러 번 지정되면 인자를 합치며, 문자열과 목록 인자는 하나의 목록이 됩니다. 예) @Mapper(to=A) @Mapper(to=B)
+// 합성 어노테이션은 구성 어노테이션으로 펼쳐지며, 합성 어노테이션 자신의 이름도 결과에 포함되어 검증에 사용할 수 있습니다.
+// plugins 에 정의된 어노테이션도 함께 찾습니다.
+func collectAnnotations(doc *ast.CommentGroup, plugins map[string]generate.Plugin) (map[string]generate.Arguments, error) {
+	found := make(map[string]generate.Arguments)
+	if doc == nil {
+		return found, nil
+	}
+
+	composites := make([]string, 0)
+	for _, comment := range doc.List {
+		lexed, err := lexAnnotations(comment, plugins)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, annotation := range lexed {
+			if !isKnown(annotation.name, plugins) {
+				continue
+			}
+
+			if _, isComposite := compositeAnnotations[annotation.name]; isComposite && annotation.name != "HashCode" {
+				if len(annotation.args) > 0 {
+					return nil, fmt.Errorf("@%s does not accept arguments", annotation.name)
+				}
+				composites = append(composites, annotation.name)
+				continue
+			}
+
+			args, exists := found[annotation.name]
+			if !exists {
+				found[annotation.name] = annotation.args
+				continue
+			}
+
+			for key, value := range annotation.args {
+				if previous, ok := args[key]; ok {
+					_, previousIsBool := previous.(bool)
+					_, valueIsBool := value.(bool)
+					if !previousIsBool && !valueIsBool {
+						value = append(args.List(key), annotation.args.List(key)...)
+					}
+				}
+				args[key] = value
+			}
+		}
+	}
+
+	// @HashCode 는 생성되는 어노테이션이면서 @Equals 를 함께 생성합니다.
+	if _, ok := found["HashCode"]; ok {
+		composites = append(composites, "HashCode")
+	}
+
+	for _, composite := range composites {
+		if _, exists := found[composite]; !exists {
+			found[composite] = make(generate.Arguments)
+		}
+
+		// 개별 어노테이션이 이미 지정되어 있다면 해당 설정을 유지합니다.
+		for _, member := range compositeAnnotations[composite] {
+			if _, exists := found[member]; !exists {
+				found[member] = make(generate.Arguments)
+			}
+		}
+	}
+
+	return found, nil
+}
+
+// withDefaults 는 어노테이션에 작성하지 않은 인자를 설정 파일의 기본 인자로 채웁니다.
+func withDefaults(found map[string]generate.Arguments, cfg *config.Config) map[string]generate.Arguments {
+	for annotation, args := range found {
+		for key, value := range cfg.Arguments(annotation) {
+			if _, exists := args[key]; !exists {
+				args[key] = value
+			}
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,385 @@
+package parser
+
+import (
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/YangTaeyoung/gombok/generate"
+)
+
+// commentGroup 은 각 줄을 하나의 주석으로 하는 주석 그룹을 만듭니다.
+func commentGroup(lines ...string) *ast.CommentGroup {
+	group := &ast.CommentGroup{}
+	for _, line := range lines {
+		group.List = append(group.List, &ast.Comment{Slash: 1, Text: line})
+	}
+
+	return group
+}
+
+func TestLexAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		want    []string
+		args    []generate.Arguments
+		wantErr bool
+	}{
+		{
+			name:    "several annotations on one line",
+			comment: "// @Getter @Setter",
+			want:    []string{"Getter", "Setter"},
+			args:    []generate.Arguments{{}, {}},
+		},
+		{
+			name:    "arguments",
+			comment: `// @Builder(prefix="Set", build=Finish)`,
+			want:    []string{"Builder"},
+			args:    []generate.Arguments{{"prefix": "Set", "build": "Finish"}},
+		},
+		{
+			name:    "default shorthand",
+			comment: "// @Builder.Default",
+			want:    []string{"Builder"},
+			args:    []generate.Arguments{{"default": true}},
+		},
+		{
+			name:    "block comment",
+			comment: "/*\n * @Getter\n * @ToString(exclude=[Password])\n */",
+			want:    []string{"Getter", "ToString"},
+			args:    []generate.Arguments{{}, {"exclude": []string{"Password"}}},
+		},
+		{
+			name:    "description after annotation",
+			comment: "// @Getter generates getters",
+			want:    []string{"Getter"},
+			args:    []generate.Arguments{{}},
+		},
+		{
+			name:    "annotation inside description",
+			comment: "// don't add @Getter here",
+			want:    []string{},
+			args:    []generate.Arguments{},
+		},
+		{
+			name:    "unknown annotation is lexed with its full name",
+			comment: "// @GetterX @Setter",
+			want:    []string{"GetterX", "Setter"},
+			args:    []generate.Arguments{{}, {}},
+		},
+		{
+			name:    "unterminated arguments",
+			comment: `// @Builder(prefix="Set"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexed, err := lexAnnotations(&ast.Comment{Slash: 1, Text: tt.comment}, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("lexAnnotations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			names := make([]string, 0, len(lexed))
+			args := make([]generate.Arguments, 0, len(lexed))
+			for _, annotation := range lexed {
+				names = append(names, annotation.name)
+				args = append(args, annotation.args)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("lexAnnotations() names = %v, want %v", names, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("lexAnnotations() args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestParseArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    generate.Arguments
+		length  int
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			text:   "() rest",
+			want:   generate.Arguments{},
+			length: 2,
+		},
+		{
+			name:   "quoted and bare strings",
+			text:   `(prefix="Set", build=Finish)`,
+			want:   generate.Arguments{"prefix": "Set", "build": "Finish"},
+			length: 28,
+		},
+		{
+			name:   "bool",
+			text:   "(default=true, chain=false)",
+			want:   generate.Arguments{"default": true, "chain": false},
+			length: 27,
+		},
+		{
+			name:   "list",
+			text:   `(to=[UserDTO, "dto.User"])`,
+			want:   generate.Arguments{"to": []string{"UserDTO", "dto.User"}},
+			length: 26,
+		},
+		{
+			name:   "empty list",
+			text:   "(to=[])",
+			want:   generate.Arguments{"to": []string{}},
+			length: 7,
+		},
+		{
+			name:    "duplicate key",
+			text:    "(prefix=Set, prefix=With)",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string",
+			text:    `(prefix="Set)`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated list",
+			text:    "(to=[A, B",
+			wantErr: true,
+		},
+		{
+			name:    "missing closing parenthesis",
+			text:    "(prefix=Set",
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			text:    "(prefix=)",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, length, err := parseArguments(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseArguments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(args, tt.want) {
+				t.Errorf("parseArguments() = %v, want %v", args, tt.want)
+			}
+			if length != tt.length {
+				t.Errorf("parseArguments() length = %d, want %d", length, tt.length)
+			}
+		})
+	}
+}
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    any
+		next    int
+		wantErr bool
+	}{
+		{name: "quoted string", text: `"a, b")`, want: "a, b", next: 6},
+		{name: "bare string", text: "Finish)", want: "Finish", next: 6},
+		{name: "qualified name", text: "dto.User)", want: "dto.User", next: 8},
+		{name: "true", text: "true)", want: true, next: 4},
+		{name: "false", text: "false)", want: false, next: 5},
+		{name: "list", text: `[A, "b c"])`, want: []string{"A", "b c"}, next: 10},
+		{name: "unexpected token in list", text: "[A; B]", wantErr: true},
+		{name: "empty list element", text: "[A, ]", wantErr: true},
+		{name: "missing value", text: "", wantErr: true},
+		{name: "not a value", text: ")", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, next, err := parseValue(tt.text, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(value, tt.want) {
+				t.Errorf("parseValue() = %v, want %v", value, tt.want)
+			}
+			if next != tt.next {
+				t.Errorf("parseValue() next = %d, want %d", next, tt.next)
+			}
+		})
+	}
+}
+
+func TestReadString(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		next    int
+		wantErr bool
+	}{
+		{name: "simple", text: `"Set")`, want: "Set", next: 5},
+		{name: "escaped quote", text: `"a\"b")`, want: `a"b`, next: 6},
+		{name: "escape sequence", text: `"a\tb"`, want: "a\tb", next: 6},
+		{name: "missing closing quote", text: `"Set)`, wantErr: true},
+		{name: "invalid escape", text: `"a\qb"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, next, err := readString(tt.text, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if value != tt.want {
+				t.Errorf("readString() = %q, want %q", value, tt.want)
+			}
+			if next != tt.next {
+				t.Errorf("readString() next = %d, want %d", next, tt.next)
+			}
+		})
+	}
+}
+
+func TestCollectAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     *ast.CommentGroup
+		want    map[string]generate.Arguments
+		wantErr bool
+	}{
+		{
+			name: "repeated mapper targets are merged",
+			doc:  commentGroup("// @Mapper(to=UserDTO)", `// @Mapper(to=["dto.User", UserView])`),
+			want: map[string]generate.Arguments{"Mapper": {"to": []string{"UserDTO", "dto.User", "UserView"}}},
+		},
+		{
+			name: "default shorthand",
+			doc:  commentGroup("// @Builder.Default"),
+			want: map[string]generate.Arguments{"Builder": {"default": true}},
+		},
+		{
+			name: "composite annotation",
+			doc:  commentGroup("// @Value"),
+			want: map[string]generate.Arguments{
+				"Value":              {},
+				"AllArgsConstructor": {},
+				"Getter":             {},
+				"ToString":           {},
+				"Equals":             {},
+			},
+		},
+		{
+			name: "block comment",
+			doc:  commentGroup("/* @Getter\n * @Setter(prefix=Update) */"),
+			want: map[string]generate.Arguments{"Getter": {}, "Setter": {"prefix": "Update"}},
+		},
+		{
+			name: "annotation inside description",
+			doc:  commentGroup("// don't add @Getter here"),
+			want: map[string]generate.Arguments{},
+		},
+		{
+			name: "unknown annotation does not match a prefix",
+			doc:  commentGroup("// @GetterX"),
+			want: map[string]generate.Arguments{},
+		},
+		{
+			name:    "composite annotation with arguments",
+			doc:     commentGroup("// @Data(prefix=Get)"),
+			wantErr: true,
+		},
+		{
+			name:    "duplicate key",
+			doc:     commentGroup("// @Builder(prefix=Set, prefix=With)"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found, err := collectAnnotations(tt.doc, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("collectAnnotations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if !reflect.DeepEqual(found, tt.want) {
+				t.Errorf("collectAnnotations() = %v, want %v", found, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnnotationWarnings(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   []string
+	}{
+		{
+			name:   "annotation inside description",
+			source: "package p\n\n// don't add @Getter here\ntype T struct{}\n",
+			want:   []string{},
+		},
+		{
+			name:   "unknown suffix",
+			source: "package p\n\n// @GetterX\ntype T struct{}\n",
+			want:   []string{"t.go:3: unknown annotation @GetterX, did you mean @Getter?"},
+		},
+		{
+			name:   "typo",
+			source: "package p\n\n// User is a user.\n//\n// @Getter @Bulder\ntype T struct{}\n",
+			want:   []string{"t.go:5: unknown annotation @Bulder, did you mean @Builder?"},
+		},
+		{
+			name:   "block comment",
+			source: "package p\n\n/*\n * @Getter\n * @Settr\n */\ntype T struct{}\n",
+			want:   []string{"t.go:5: unknown annotation @Settr, did you mean @Setter?"},
+		},
+		{
+			name:   "no similar annotation",
+			source: "package p\n\n// @Repository\ntype T struct{}\n",
+			want:   []string{"t.go:3: unknown annotation @Repository"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := goparser.ParseFile(fset, "t.go", tt.source, goparser.ParseComments)
+			if err != nil {
+				t.Fatalf("ParseFile() error = %v", err)
+			}
+
+			warnings := annotationWarnings(fset, file.Decls[0].(*ast.GenDecl).Doc, nil)
+			if !reflect.DeepEqual(warnings, tt.want) {
+				t.Errorf("annotationWarnings() = %q, want %q", warnings, tt.want)
+			}
+		})
+	}
+}
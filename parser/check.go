@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aymanbagabas/go-udiff"
+)
+
+// Check 는 파일을 작성하지 않고 생성될 파일과 디스크의 파일을 비교합니다.
+// 내용이 다른 파일은 unified diff 를 출력하며, 하나라도 다르다면 오류를 반환합니다.
+func Check(opts Options) error {
+	s, err := newScope(opts)
+	if err != nil {
+		return err
+	}
+
+	generated, failed, err := generatePackages(s)
+	if err != nil {
+		return fmt.Errorf("loading packages: %w", err)
+	}
+
+	outdated := 0
+	for _, file := range generated {
+		current, err := os.ReadFile(file.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("reading %s: %w", file.path, err)
+		}
+
+		if bytes.Equal(current, file.content) {
+			continue
+		}
+
+		name, err := filepath.Rel(s.root, file.path)
+		if err != nil {
+			name = file.path
+		}
+
+		// 생성되지 않은 파일과 삭제될 파일은 /dev/null 과 비교합니다.
+		oldLabel, newLabel := "a/"+name, "b/"+name
+		if current == nil {
+			oldLabel = "/dev/null"
+		}
+		if file.content == nil {
+			newLabel = "/dev/null"
+		}
+
+		fmt.Print(udiff.Unified(oldLabel, newLabel, string(current), string(file.content)))
+		outdated++
+	}
+
+	if outdated > 0 {
+		return fmt.Errorf("%d generated file(s) are out of date, run gombok to regenerate them", outdated)
+	}
+
+	return failedError(failed)
+}
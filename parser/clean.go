@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	filepkg "github.com/YangTaeyoung/gombok/file"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Clean 은 범위에 포함된 패키지에서 gombok 이 생성한 파일을 삭제합니다.
+// 생성된 파일은 "Code generated by gombok" 주석으로 확인하며, 범위에 포함되지 않는 파일은 삭제하지 않습니다.
+func Clean(opts Options) error {
+	s, err := newScope(opts)
+	if err != nil {
+		return err
+	}
+
+	// 생성된 파일에 컴파일 오류가 있을 수 있으므로 타입 정보 없이 파일 목록만 읽습니다.
+	loaded, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedFiles, Dir: s.root}, s.patterns...)
+	if err != nil {
+		return fmt.Errorf("loading packages: %w", err)
+	}
+
+	for _, loadedPkg := range loaded {
+		cfg, err := s.loadConfig(loadedPkg)
+		if err != nil {
+			return err
+		}
+
+		for _, path := range loadedPkg.GoFiles {
+			if !s.includesGenerated(path, cfg) {
+				continue
+			}
+
+			isGenerated, err := filepkg.IsGenerated(path)
+			if err != nil {
+				log.Printf("Error reading file %s: %v", path, err)
+				continue
+			}
+
+			if !isGenerated {
+				continue
+			}
+
+			fmt.Println("Removed", filepath.Base(path))
+			if err = os.Remove(path); err != nil {
+				log.Printf("Error removing file %s: %v", path, err)
+			}
+		}
+	}
+
+	return nil
+}
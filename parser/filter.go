@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// skippedDirs 는 항상 건너뛰는 디렉토리입니다. . 또는 _ 로 시작하는 디렉토리도 건너뜁니다.
+var skippedDirs = map[string]bool{
+	"vendor":   true,
+	"testdata": true,
+}
+
+// Filter 는 코드를 생성할 소스 파일을 고르는 glob 패턴입니다. 패턴은 작업 디렉토리 기준의 상대 경로와 비교합니다.
+// 디렉토리와 일치하는 패턴은 하위의 모든 파일에 적용되며, / 가 없는 패턴은 파일 이름, 디렉토리 이름과도 비교합니다.
+type Filter struct {
+	// Exclude 와 일치하는 파일은 Include 와 관계없이 건너뜁니다.
+	Exclude []string
+	// Include 가 지정되면 일치하는 파일만 사용합니다.
+	Include []string
+}
+
+// skip 은 root 기준 경로가 rel 인 소스 파일을 건너뛰어야 하는지 확인합니다.
+func (f Filter) skip(rel string) bool {
+	rel = filepath.ToSlash(rel)
+
+	dirs := strings.Split(path.Dir(rel), "/")
+	for _, dir := range dirs {
+		if dir == "." || dir == ".." {
+			continue
+		}
+		if skippedDirs[dir] || strings.HasPrefix(dir, ".") || strings.HasPrefix(dir, "_") {
+			return true
+		}
+	}
+
+	for _, pattern := range f.Exclude {
+		if matchGlob(pattern, rel) {
+			return true
+		}
+	}
+
+	if len(f.Include) == 0 {
+		return false
+	}
+
+	for _, pattern := range f.Include {
+		if matchGlob(pattern, rel) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchGlob 은 경로 또는 경로의 상위 디렉토리가 패턴과 일치하는지 확인합니다.
+func matchGlob(pattern, rel string) bool {
+	pattern = strings.TrimSuffix(filepath.ToSlash(pattern), "/")
+
+	// / 가 없는 패턴은 파일 이름, 디렉토리 이름과 비교합니다. 예) *_dto.go, legacy
+	if !strings.Contains(pattern, "/") {
+		for _, name := range strings.Split(rel, "/") {
+			if matched, _ := path.Match(pattern, name); matched {
+				return true
+			}
+		}
+	}
+
+	for current := rel; current != "." && current != "/"; current = path.Dir(current) {
+		if matched, _ := path.Match(pattern, current); matched {
+			return true
+		}
+	}
+
+	return false
+}
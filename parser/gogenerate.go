@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// goGenerate 는 go generate 로 실행되었을 때 go generate 가 전달하는 환경 변수입니다.
+type goGenerate struct {
+	// file 은 //go:generate 지시문이 작성된 파일의 이름입니다. ($GOFILE)
+	file string
+	// line 은 지시문이 작성된 줄 번호입니다. ($GOLINE)
+	line int
+	// pkg 는 지시문이 작성된 파일의 패키지 이름입니다. ($GOPACKAGE)
+	pkg string
+}
+
+// lookupGoGenerate 는 go generate 로 실행되었는지 확인하고 환경 변수를 읽습니다.
+func lookupGoGenerate() (goGenerate, bool) {
+	file := os.Getenv("GOFILE")
+	if file == "" {
+		return goGenerate{}, false
+	}
+
+	// $GOLINE 이 없거나 잘못되었다면 0 으로 보고 첫 번째 지시문으로 취급합니다.
+	line, _ := strconv.Atoi(os.Getenv("GOLINE"))
+
+	return goGenerate{file: file, line: line, pkg: os.Getenv("GOPACKAGE")}, true
+}
+
+// isFirstDirective 는 지시문이 파일의 첫 번째 gombok 지시문인지 확인합니다.
+// 코드는 소스 파일 단위로 생성되므로 첫 번째 지시문이 파일의 모든 타입에 대한 코드를 생성하며,
+// 타입마다 지시문을 작성하더라도 나머지 지시문은 같은 파일을 다시 생성하지 않습니다.
+func (g goGenerate) isFirstDirective() (bool, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, g.file, nil, parser.ParseComments)
+	if err != nil {
+		return false, fmt.Errorf("parsing %s: %w", g.file, err)
+	}
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if !isGombokDirective(comment.Text) {
+				continue
+			}
+
+			return fset.Position(comment.Slash).Line >= g.line, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isGombokDirective 는 주석이 gombok 을 실행하는 //go:generate 지시문인지 확인합니다.
+func isGombokDirective(text string) bool {
+	command, ok := strings.CutPrefix(text, "//go:generate ")
+	if !ok {
+		return false
+	}
+
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return false
+	}
+
+	// gombok 을 직접 실행하거나 go run 으로 실행하는 경우를 모두 찾습니다.
+	for _, field := range fields {
+		if strings.HasPrefix(field, "-") || field == "go" || field == "run" {
+			continue
+		}
+
+		name := filepath.Base(strings.Split(field, "@")[0])
+		return name == "gombok"
+	}
+
+	return false
+}
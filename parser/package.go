@@ -0,0 +1,193 @@
+package parser
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/YangTaeyoung/gombok/config"
+	filepkg "github.com/YangTaeyoung/gombok/file"
+	"github.com/YangTaeyoung/gombok/generate"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// packageInfo 는 패키지의 모든 파일에서 모은 정보입니다.
+type packageInfo struct {
+	// typeAnnotations 는 타입 이름별로 지정된 어노테이션입니다.
+	typeAnnotations map[string]map[string]generate.Arguments
+	// constants 는 타입 이름별로 해당 타입으로 선언된 상수입니다.
+	constants map[string][]*ast.ValueSpec
+	// structs 는 타입 이름별 구조체 선언입니다.
+	structs map[string]*ast.StructType
+	// typeParams 는 타입 이름별 타입 매개변수 선언입니다.
+	typeParams map[string]*ast.FieldList
+	// methods 는 타입 이름별로 직접 선언된 메서드 이름입니다. gombok 이 생성한 메서드는 포함하지 않습니다.
+	methods map[string]map[string]bool
+	// types 와 info 는 패키지의 타입 정보입니다.
+	types *types.Package
+	info  *types.Info
+	// fset 은 경고 메시지에 사용할 파일의 위치 정보입니다.
+	fset *token.FileSet
+}
+
+// newPackageInfo 는 패키지의 모든 파일에서 타입별로 지정된 어노테이션과 상수, 구조체, 메서드를 모읍니다.
+// 같은 패키지의 다른 파일에 선언된 타입과 상수를 참조하기 위해 사용합니다.
+// plugins 는 설정 파일에서 정의한 어노테이션으로, 인자가 있는 플러그인 어노테이션 뒤의 어노테이션도 읽기 위해 사용합니다.
+func newPackageInfo(loaded *packages.Package, plugins map[string]generate.Plugin) *packageInfo {
+	pkg := &packageInfo{
+		typeAnnotations: make(map[string]map[string]generate.Arguments),
+		constants:       make(map[string][]*ast.ValueSpec),
+		structs:         make(map[string]*ast.StructType),
+		typeParams:      make(map[string]*ast.FieldList),
+		methods:         make(map[string]map[string]bool),
+		types:           loaded.Types,
+		info:            loaded.TypesInfo,
+		fset:            loaded.Fset,
+	}
+
+	for _, file := range loaded.Syntax {
+		isGenerated := filepkg.HasGeneratedHeader(file)
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+				if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || isGenerated {
+					continue
+				}
+
+				receiverName := receiverTypeName(funcDecl.Recv.List[0].Type)
+				if pkg.methods[receiverName] == nil {
+					pkg.methods[receiverName] = make(map[string]bool)
+				}
+				pkg.methods[receiverName][funcDecl.Name.Name] = true
+				continue
+			}
+
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			switch genDecl.Tok {
+			case token.TYPE:
+				for _, spec := range genDecl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					// 어노테이션 인자의 오류는 코드를 생성할 때 기록하므로 여기서는 무시합니다.
+					pkg.typeAnnotations[typeSpec.Name.Name], _ = collectAnnotations(genDecl.Doc, plugins)
+					pkg.typeParams[typeSpec.Name.Name] = typeSpec.TypeParams
+					if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+						pkg.structs[typeSpec.Name.Name] = structType
+					}
+				}
+			case token.CONST:
+				// 타입과 값이 생략된 상수는 앞 상수의 타입을 이어받습니다.
+				var typeName string
+				for _, spec := range genDecl.Specs {
+					valueSpec, ok := spec.(*ast.ValueSpec)
+					if !ok {
+						continue
+					}
+
+					if valueSpec.Type != nil {
+						typeName = ""
+						if ident, ok := valueSpec.Type.(*ast.Ident); ok {
+							typeName = ident.Name
+						}
+					} else if len(valueSpec.Values) > 0 {
+						typeName = ""
+					}
+
+					if typeName != "" {
+						pkg.constants[typeName] = append(pkg.constants[typeName], valueSpec)
+					}
+				}
+			}
+		}
+	}
+
+	return pkg
+}
+
+// importName 은 import 경로에 해당하는 패키지의 이름을 찾습니다. 패키지가 의존하는 패키지까지 찾으며, 찾지 못하면 빈 문자열을 반환합니다.
+func (p *packageInfo) importName(path string) string {
+	if p.types == nil {
+		return ""
+	}
+
+	visited := make(map[string]bool)
+	queue := []*types.Package{p.types}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, imported := range current.Imports() {
+			if visited[imported.Path()] {
+				continue
+			}
+			if imported.Path() == path {
+				return imported.Name()
+			}
+
+			visited[imported.Path()] = true
+			queue = append(queue, imported)
+		}
+	}
+
+	return ""
+}
+
+// receiverTypeName 은 메서드 receiver 의 타입 이름을 구합니다.
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+
+	return ""
+}
+
+// otherOptionFunctions 는 패키지에서 typeName 이 아닌 @Options 구조체가 생성하는 Option 함수 이름의 집합입니다.
+func (p *packageInfo) otherOptionFunctions(typeName string, cfg *config.Config) map[string]bool {
+	funcNames := make(map[string]bool)
+	for other, found := range p.typeAnnotations {
+		args, ok := found["Options"]
+		structType, isStruct := p.structs[other]
+		if !ok || !isStruct || other == typeName {
+			continue
+		}
+
+		// typeAnnotations 의 인자를 바꾸지 않도록 복사한 인자에 기본 인자를 적용합니다.
+		merged := make(generate.Arguments)
+		for key, value := range args {
+			merged[key] = value
+		}
+		merged = withDefaults(map[string]generate.Arguments{"Options": merged}, cfg)["Options"]
+
+		for _, funcName := range generate.OptionFunctions(structType.Fields.List, merged) {
+			funcNames[funcName] = true
+		}
+	}
+
+	return funcNames
+}
+
+// typesWith 는 어노테이션이 지정된 타입의 집합을 반환합니다.
+func typesWith(typeAnnotations map[string]map[string]generate.Arguments, annotation string) map[string]bool {
+	types := make(map[string]bool)
+	for typeName, found := range typeAnnotations {
+		if _, ok := found[annotation]; ok {
+			types[typeName] = true
+		}
+	}
+
+	return types
+}
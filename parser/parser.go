@@ -1,230 +1,763 @@
 package parser
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
-	"go/parser"
+	goparser "go/parser"
 	"go/token"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/YangTaeyoung/gombok/config"
 	filepkg "github.com/YangTaeyoung/gombok/file"
 	"github.com/YangTaeyoung/gombok/generate"
+
+	"golang.org/x/tools/go/packages"
 )
 
-func Run() {
-	root, err := os.Getwd()
-	if err != nil {
-		fmt.Println("Error getting current directory:", err)
-		return
-	}
+// loadMode 는 어노테이션을 찾고 타입 정보를 사용하기 위해 패키지를 읽는 범위입니다.
+// x/tools v0.24 의 go/packages 는 최신 Go 에서 의존 패키지의 타입 정보를 export data 로 읽지 못하므로 NeedDeps 로 의존 패키지도 함께 읽습니다.
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo
+
+// generatedFile 은 생성될 파일의 경로와 내용입니다.
+// content 가 nil 이라면 더 이상 생성되지 않는 파일로, 삭제되어야 합니다.
+type generatedFile struct {
+	path    string
+	content []byte
+}
+
+// Options 는 생성된 코드를 출력하는 방식을 지정합니다.
+type Options struct {
+	// DryRun 이 true 라면 파일을 작성하지 않고 생성되거나 변경될 파일의 목록만 출력합니다.
+	DryRun bool
+	// Stdout 이 지정되면 파일을 작성하지 않고 해당 소스 파일(.go) 또는 타입에 대해 생성될 코드를 출력합니다.
+	Stdout string
+	// Filter 는 코드를 생성할 소스 파일을 고릅니다.
+	Filter Filter
+	// Patterns 는 코드를 생성할 패키지 패턴 또는 소스 파일입니다. 지정하지 않으면 작업 디렉토리 아래의 모든 패키지입니다.
+	Patterns []string
+}
 
-	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
-		var (
-			fileContent       string
-			requireReflectPkg bool
-		)
+func Run(opts Options) error {
+	// --stdout 의 값이 .go 로 끝난다면 해당 소스 파일의 코드만, 아니라면 타입 이름으로 보고 해당 타입의 코드만 생성합니다.
+	isSource := strings.HasSuffix(opts.Stdout, ".go")
+	if isSource {
+		opts.Patterns = []string{opts.Stdout}
+	}
 
+	// go generate 로 실행되었다면 작업 디렉토리 전체가 아닌 지시문이 작성된 파일의 코드만 생성합니다.
+	g, isGoGenerate := lookupGoGenerate()
+	isGoGenerate = isGoGenerate && len(opts.Patterns) == 0 && opts.Stdout == ""
+	if isGoGenerate {
+		first, err := g.isFirstDirective()
 		if err != nil {
 			return err
 		}
 
-		if info.IsDir() || !strings.HasSuffix(path, ".go") {
+		if !first {
+			log.Printf("Skipping %s:%d, the first gombok directive in the file already generates it", g.file, g.line)
 			return nil
 		}
 
-		fmt.Println(info.Name())
+		opts.Patterns = []string{g.file}
+	}
+
+	s, err := newScope(opts)
+	if err != nil {
+		return err
+	}
+
+	if isGoGenerate {
+		s.pkgName = g.pkg
+	}
+
+	if opts.Stdout != "" && !isSource {
+		s.onlyType = opts.Stdout
+	}
+
+	generated, failed, err := generatePackages(s)
+	if err != nil {
+		return fmt.Errorf("loading packages: %w", err)
+	}
+
+	switch {
+	case opts.Stdout != "":
+		err = printGenerated(generated, opts.Stdout)
+	case opts.DryRun:
+		err = printChanges(s.root, generated)
+	default:
+		writeGenerated(generated)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return failedError(failed)
+}
+
+// failedError 는 코드를 생성하지 못한 파일이 있다면 오류를 반환합니다.
+func failedError(failed int) error {
+	if failed > 0 {
+		return fmt.Errorf("could not generate code for %d file(s), their previous output is kept", failed)
+	}
+
+	return nil
+}
+
+// writeGenerated 는 생성된 파일을 작성하고 더 이상 생성되지 않는 파일을 삭제합니다.
+func writeGenerated(generated []generatedFile) {
+	for _, file := range generated {
+		if file.content == nil {
+			fmt.Println("Removed", filepath.Base(file.path))
+			if err := os.Remove(file.path); err != nil {
+				log.Printf("Error removing file %s: %v", file.path, err)
+			}
+			continue
+		}
+
+		fmt.Println(filepath.Base(file.path))
+		if err := os.WriteFile(file.path, file.content, 0644); err != nil {
+			log.Printf("Error writing file %s: %v", file.path, err)
+		}
+	}
+}
+
+// printGenerated 는 생성될 코드를 표준 출력으로 출력합니다.
+func printGenerated(generated []generatedFile, target string) error {
+	printed := false
+	for _, file := range generated {
+		if file.content == nil {
+			continue
+		}
+
+		fmt.Print(string(file.content))
+		printed = true
+	}
+
+	if !printed {
+		return fmt.Errorf("no code is generated for %s", target)
+	}
+
+	return nil
+}
+
+// printChanges 는 파일을 작성하지 않고 생성되거나 변경되거나 삭제될 파일의 목록을 출력합니다.
+func printChanges(root string, generated []generatedFile) error {
+	for _, file := range generated {
+		current, err := os.ReadFile(file.path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("reading %s: %w", file.path, err)
+		}
 
-		content, err := os.ReadFile(path)
+		name, err := filepath.Rel(root, file.path)
 		if err != nil {
-			return err
+			name = file.path
 		}
 
-		fset := token.NewFileSet()
-		file, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+		switch {
+		case file.content == nil:
+			fmt.Println("delete", name)
+		case current == nil:
+			fmt.Println("create", name)
+		case !bytes.Equal(current, file.content):
+			fmt.Println("update", name)
+		}
+	}
+
+	return nil
+}
+
+// generatePackages 는 범위에 포함된 패키지를 읽어 생성될 파일과 코드를 생성하지 못한 파일의 수를 반환합니다. 파일은 작성하지 않습니다.
+func generatePackages(s scope) ([]generatedFile, int, error) {
+	// 파일 단위가 아닌 패키지 단위로 읽어 다른 파일에 선언된 타입과 다른 패키지의 타입 정보를 함께 사용합니다.
+	loaded, err := packages.Load(&packages.Config{Mode: loadMode, Dir: s.root}, s.patterns...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	generated := make([]generatedFile, 0)
+	failed := 0
+	for _, loadedPkg := range loaded {
+		if s.pkgName != "" && loadedPkg.Name != s.pkgName {
+			continue
+		}
+
+		// 이전에 생성된 파일에 오류가 있더라도 타입 정보는 사용할 수 있으므로 오류는 기록만 합니다.
+		for _, loadErr := range loadedPkg.Errors {
+			log.Println("Error loading package:", loadErr)
+		}
+
+		// 코드를 생성하지 못한 파일의 이전 결과는 삭제하지 않습니다.
+		keep := make(map[string]bool)
+		cfg, err := s.loadConfig(loadedPkg)
 		if err != nil {
-			return err
+			return nil, 0, err
+		}
+
+		pkg := newPackageInfo(loadedPkg, cfg.Plugins)
+		if cfg.Output == config.OutputPackage && len(loadedPkg.GoFiles) > 0 {
+			result, err := generatePackage(s, loadedPkg, pkg, cfg)
+			if err != nil {
+				fmt.Println("Error processing files:", err)
+				keep[packageFilePath(loadedPkg)] = true
+				failed++
+			}
+
+			if result != nil {
+				generated = append(generated, *result)
+				keep[result.path] = true
+			}
 		}
 
-		importPkgs := make([]filepkg.ImportPackage, 0)
-		ast.Inspect(file, func(n ast.Node) bool {
+		for _, file := range loadedPkg.Syntax {
+			// 패키지 단위로 생성했다면 소스 파일별로 생성하지 않습니다.
+			if cfg.Output == config.OutputPackage {
+				break
+			}
+
+			path := loadedPkg.Fset.File(file.Pos()).Name()
+			if !s.includes(path, cfg) {
+				keep[generatedPath(path, cfg.Suffix)] = true
+				continue
+			}
 
-			// 주석을 찾는다.
-			switch x := n.(type) {
-			case *ast.ImportSpec:
-				if x.Path != nil {
-					importPath := strings.Trim(x.Path.Value, "\"")
+			result, err := generateFile(pkg, file, path, s.onlyType, cfg)
+			if err != nil {
+				fmt.Println("Error processing files:", err)
+				keep[generatedPath(path, cfg.Suffix)] = true
+				failed++
+				continue
+			}
 
-					// 중복을 방지하기 위해 이미 importPkgs에 포함되어 있는지 확인
-					alreadyIncluded := false
-					for _, pkg := range importPkgs {
-						if pkg.Path == importPath {
-							alreadyIncluded = true
-							break
-						}
-					}
-					if !alreadyIncluded {
-						var alias string
-						if x.Name != nil {
-							alias = x.Name.Name
+			if result != nil {
+				generated = append(generated, *result)
+				keep[result.path] = true
+			}
+		}
+
+		// 특정 타입만 생성할 때는 다른 타입의 결과를 알 수 없으므로 삭제할 파일을 찾지 않습니다.
+		if s.onlyType != "" {
+			continue
+		}
+
+		for _, path := range loadedPkg.GoFiles {
+			if keep[path] || !s.includesGenerated(path, cfg) {
+				continue
+			}
+
+			// 어노테이션이 모두 삭제되었거나 소스 파일이 삭제되어 더 이상 생성되지 않는 파일입니다.
+			isGenerated, err := filepkg.IsGenerated(path)
+			if err != nil {
+				log.Printf("Error reading file %s: %v", path, err)
+				continue
+			}
+
+			if isGenerated {
+				generated = append(generated, generatedFile{path: path})
+			}
+		}
+	}
+
+	return generated, failed, nil
+}
+
+// generatePackage 는 패키지의 모든 소스 파일에서 생성된 코드를 zz_generated.gombok.go 파일 하나로 생성합니다.
+// 파일 하나만 다시 생성하면 나머지 파일의 코드가 사라지므로 소스 파일을 지정하더라도 패키지 전체를 생성합니다.
+func generatePackage(s scope, loadedPkg *packages.Package, pkg *packageInfo, cfg *config.Config) (*generatedFile, error) {
+	codes := make([]*fileCode, 0)
+	importPkgs := make([]filepkg.ImportPackage, 0)
+	// importedBy 는 패키지 이름별로 해당 이름으로 참조되는 패키지와 그 패키지를 참조하는 소스 파일입니다.
+	importedBy := make(map[string]struct{ path, source string })
+	for _, file := range loadedPkg.Syntax {
+		path := loadedPkg.Fset.File(file.Pos()).Name()
+		if skipPath(s.root, path, s.filter) || excludedByConfig(path, cfg) {
+			continue
+		}
+
+		code, err := generateCode(pkg, file, path, s.onlyType, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if code == nil {
+			continue
+		}
+		codes = append(codes, code)
+
+		// 소스 파일마다 같은 이름으로 다른 패키지를 참조할 수 있으므로 각 파일의 코드가 참조하는 패키지만 합칩니다.
+		var content string
+		for _, t := range code.types {
+			content += t.content
+		}
+		used, err := filepkg.UsedImports(content, code.imports)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for _, importPkg := range used {
+			name := importPkg.Qualifier()
+			// 하나의 파일에서는 같은 이름으로 두 패키지를 참조할 수 없으므로 오류를 반환합니다.
+			if other, exists := importedBy[name]; exists && other.path != importPkg.Path {
+				return nil, fmt.Errorf("generated code refers to %q from %s and %q from %s by the same name %s, alias one of the imports or use output: %s", other.path, other.source, importPkg.Path, path, name, config.OutputFile)
+			}
+			importedBy[name] = struct{ path, source string }{importPkg.Path, path}
+			importPkgs = appendImport(importPkgs, importPkg)
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	types := make([]typeCode, 0)
+	for _, code := range codes {
+		types = append(types, code.types...)
+	}
+
+	// 파일이 나뉘거나 합쳐지더라도 결과가 바뀌지 않도록 코드는 타입 이름 순서로 정렬합니다.
+	sort.SliceStable(types, func(i, j int) bool {
+		return types[i].name < types[j].name
+	})
+
+	var fileContent string
+	for _, t := range types {
+		fileContent += t.content
+	}
+
+	newFilePath := packageFilePath(loadedPkg)
+	if err := checkOverwrite(newFilePath); err != nil {
+		return nil, err
+	}
+
+	content, err := filepkg.Render(codes[0].packageName, cfg.Header, importPkgs, fileContent, newFilePath)
+	if err != nil {
+		log.Printf("Error formatting file %s: %v", newFilePath, err)
+		return nil, err
+	}
+
+	return &generatedFile{path: newFilePath, content: content}, nil
+}
+
+// packageFilePath 는 패키지에 대해 생성되는 zz_generated.gombok.go 파일의 경로를 반환합니다.
+func packageFilePath(loadedPkg *packages.Package) string {
+	return filepath.Join(filepath.Dir(loadedPkg.GoFiles[0]), config.PackageFileName)
+}
+
+// generatedPath 는 소스 파일에 대해 생성되는 <file><suffix> 파일의 경로를 반환합니다. 접미사의 기본값은 _gombok.go 입니다.
+func generatedPath(source string, suffix string) string {
+	return filepath.Join(filepath.Dir(source), strings.TrimSuffix(filepath.Base(source), ".go")+suffix)
+}
+
+// checkOverwrite 는 생성될 파일의 경로에 gombok 이 생성하지 않은 파일이 있다면 오류를 반환합니다.
+// 접미사를 바꾸면 직접 작성한 파일과 생성될 파일의 이름이 같을 수 있으므로 첫 줄의 주석으로 확인합니다.
+func checkOverwrite(path string) error {
+	isGenerated, err := filepkg.IsGenerated(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isGenerated {
+		return fmt.Errorf("%s was not generated by gombok, refusing to overwrite it", path)
+	}
+
+	return nil
+}
+
+// typeCode 는 타입 하나에 대해 생성된 코드입니다.
+type typeCode struct {
+	name    string
+	content string
+}
+
+// fileCode 는 소스 파일 하나에서 생성된 코드와 생성된 코드가 사용할 수 있는 import 입니다.
+type fileCode struct {
+	packageName string
+	imports     []filepkg.ImportPackage
+	types       []typeCode
+}
+
+// generateFile 은 파일에서 어노테이션을 찾아 <file>_gombok.go 파일에 작성될 코드를 생성합니다.
+// 생성할 코드가 없다면 nil 을 반환합니다. onlyType 이 지정되면 해당 타입의 코드만 생성합니다.
+// cfg 는 파일이 있는 디렉토리에 적용되는 설정입니다.
+func generateFile(pkg *packageInfo, file *ast.File, path string, onlyType string, cfg *config.Config) (*generatedFile, error) {
+	code, err := generateCode(pkg, file, path, onlyType, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if code == nil {
+		return nil, nil
+	}
+
+	var fileContent string
+	for _, t := range code.types {
+		fileContent += t.content
+	}
+
+	newFilePath := generatedPath(path, cfg.Suffix)
+	if err := checkOverwrite(newFilePath); err != nil {
+		return nil, err
+	}
+
+	content, err := filepkg.Render(code.packageName, cfg.Header, code.imports, fileContent, newFilePath)
+	if err != nil {
+		log.Printf("Error formatting file %s: %v", newFilePath, err)
+		return nil, err
+	}
+
+	return &generatedFile{path: newFilePath, content: content}, nil
+}
+
+// generateCode 는 파일에서 어노테이션을 찾아 타입별로 코드를 생성합니다. 생성할 코드가 없다면 nil 을 반환합니다.
+// @Value 의 조건을 만족하지 않는 타입이 있다면 일부 타입의 코드만 작성되지 않도록 오류를 반환합니다.
+func generateCode(pkg *packageInfo, file *ast.File, path string, onlyType string, cfg *config.Config) (*fileCode, error) {
+	var types []typeCode
+	var invalid []string
+
+	// gombok 이나 다른 도구가 생성한 파일에서는 어노테이션을 찾지 않습니다.
+	// 접미사가 같더라도 직접 작성한 파일일 수 있으므로 파일 이름이 아닌 "Code generated" 주석으로 확인합니다.
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+
+	importPkgs := make([]filepkg.ImportPackage, 0)
+	ast.Inspect(file, func(n ast.Node) bool {
+
+		// 주석을 찾는다.
+		switch x := n.(type) {
+		case *ast.ImportSpec:
+			if x.Path != nil {
+				importPath := strings.Trim(x.Path.Value, "\"")
+
+				var alias string
+				if x.Name != nil {
+					alias = x.Name.Name
+				}
+
+				importPkgs = appendImport(importPkgs, filepkg.ImportPackage{
+					Alias: alias,
+					Path:  importPath,
+					Name:  pkg.importName(importPath),
+				})
+			}
+		case *ast.GenDecl:
+			if x.Tok != token.TYPE {
+				return true
+			}
+
+			for _, warning := range annotationWarnings(pkg.fset, x.Doc, cfg.Plugins) {
+				log.Println("Warning:", warning)
+			}
+
+			for _, spec := range x.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || (onlyType != "" && typeSpec.Name.Name != onlyType) {
+					continue
+				}
+
+				found, err := collectAnnotations(x.Doc, cfg.Plugins)
+				if err != nil {
+					log.Printf("Error parsing annotations of %s: %v", typeSpec.Name.Name, err)
+					continue
+				}
+				found = withDefaults(found, cfg)
+
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					if args, isEnum := found["Enum"]; isEnum {
+						log.Printf("Found @Enum in %s", typeSpec.Name.Name)
+						result, err := generate.Enum(typeSpec.Name.Name, typeSpec.Type, pkg.constants[typeSpec.Name.Name], args, pkg.info, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Enum:", err)
+							continue
 						}
 
-						importPkgs = append(importPkgs, filepkg.ImportPackage{
-							Alias: alias,
-							Path:  importPath,
-						})
+						types = append(types, typeCode{name: typeSpec.Name.Name, content: result})
 					}
-				}
-			case *ast.GenDecl:
-				if x.Tok != token.TYPE {
-					return true
+					continue
 				}
 
-				for _, spec := range x.Specs {
-					typeSpec, ok := spec.(*ast.TypeSpec)
-					if !ok {
+				if _, ok := found["Value"]; ok {
+					log.Printf("Found @Value in %s\n", typeSpec.Name.Name)
+					if _, hasSetter := found["Setter"]; hasSetter {
+						invalid = append(invalid, fmt.Sprintf("%s cannot have both @Value and @Setter", typeSpec.Name.Name))
 						continue
 					}
-
-					structType, ok := typeSpec.Type.(*ast.StructType)
-					if !ok {
+					if err = generate.ValidateValue(typeSpec.Name.Name, structType.Fields.List); err != nil {
+						invalid = append(invalid, err.Error())
 						continue
 					}
+				}
 
-					if x.Doc == nil {
+				var typeContent string
+				delegateAt := -1
+				for _, annotation := range annotations {
+					args, ok := found[annotation]
+					if !ok {
 						continue
 					}
-
-					for _, comment := range x.Doc.List {
-						var result string
-						if strings.Contains(comment.Text, "@AllArgsConstructor") {
-							var isDefault bool
-							log.Printf("Found @AllArgsConstructor in %s\n", typeSpec.Name.Name)
-							if strings.Contains(comment.Text, ".Default") {
-								isDefault = true
-								log.Println("Found Default in @AllArgsConstructor")
-							}
-							result, err = generate.AllArgsConstructor(typeSpec.Name.Name, structType.Fields.List, isDefault)
-							if err != nil {
-								log.Println("Error generating AllArgsConstructor:", err)
-								continue
-							}
-
-							fileContent += result
+					isDefault := args.Bool("default", false)
+
+					var result string
+					switch annotation {
+					case "AllArgsConstructor":
+						log.Printf("Found @AllArgsConstructor in %s\n", typeSpec.Name.Name)
+						if isDefault {
+							log.Println("Found Default in @AllArgsConstructor")
+						}
+						result, err = generate.AllArgsConstructor(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating AllArgsConstructor:", err)
+							continue
+						}
+					case "RequiredArgsConstructor":
+						log.Printf("Found @RequiredArgsConstructor in %s\n", typeSpec.Name.Name)
+						if isDefault {
+							log.Println("Found Default in @RequiredArgsConstructor")
+						}
+						result, err = generate.RequiredArgsConstructor(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating RequiredArgsConstructor:", err)
+							continue
+						}
+					case "NoArgsConstructor":
+						log.Printf("Found @NoArgsConstructor in %s\n", typeSpec.Name.Name)
+						if isDefault {
+							log.Println("Found Default in @NoArgsConstructor")
+						}
+						result, err = generate.NoArgsConstructor(typeSpec.Name.Name, typeSpec.TypeParams, args, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating NoArgsConstructor:", err)
+							continue
+						}
+					case "Builder":
+						log.Printf("Found @Builder in %s\n", typeSpec.Name.Name)
+						result, err = generate.Builder(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, pkg.info, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Builder:", err)
+							continue
+						}
+					case "ToString":
+						log.Printf("Found @ToString in %s", typeSpec.Name.Name)
+						result, err = generate.ToString(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating ToString:", err)
+							continue
+						}
+					case "Equals":
+						log.Printf("Found @Equals in %s", typeSpec.Name.Name)
+						result, err = generate.Equals(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, typesWith(pkg.typeAnnotations, "Equals"), pkg.info, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Equals:", err)
+							continue
+						}
+					case "HashCode":
+						log.Printf("Found @HashCode in %s", typeSpec.Name.Name)
+						result, err = generate.HashCode(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, typesWith(pkg.typeAnnotations, "Equals"), typesWith(pkg.typeAnnotations, "HashCode"), pkg.info, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating HashCode:", err)
+							continue
+						}
+					case "Getter":
+						log.Printf("Found @Getter in %s", typeSpec.Name.Name)
+						result, err = generate.Getter(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Getter:", err)
+							continue
+						}
+					case "Setter":
+						log.Printf("Found @Setter in %s", typeSpec.Name.Name)
+						result, err = generate.Setter(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Setter:", err)
+							continue
+						}
+					case "Enum":
+						log.Printf("Error generating Enum: %s is a struct, @Enum requires a named non-struct type", typeSpec.Name.Name)
+						continue
+					case "Clone":
+						log.Printf("Found @Clone in %s", typeSpec.Name.Name)
+						var cloneImports []string
+						result, cloneImports, err = generate.Clone(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, typesWith(pkg.typeAnnotations, "Clone"), pkg.types, importAliases(importPkgs), pkg.info, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Clone:", err)
+							continue
 						}
-						if strings.Contains(comment.Text, "@RequiredArgsConstructor") {
-							var isDefault bool
-							log.Printf("Found @RequiredArgsConstructor in %s\n", typeSpec.Name.Name)
-							if strings.Contains(comment.Text, ".Default") {
-								isDefault = true
-								log.Println("Found Default in @RequiredArgsConstructor")
-							}
-
-							result, err = generate.RequiredArgsConstructor(typeSpec.Name.Name, structType.Fields.List, isDefault)
-							if err != nil {
-								log.Println("Error generating RequiredArgsConstructor:", err)
-								continue
-							}
 
-							fileContent += result
+						for _, importPath := range cloneImports {
+							importPkgs = appendImport(importPkgs, filepkg.ImportPackage{Path: importPath, Name: pkg.importName(importPath)})
+						}
+					case "Mapper":
+						log.Printf("Found @Mapper in %s", typeSpec.Name.Name)
+						if err = args.Validate("Mapper", mapperArguments); err != nil {
+							log.Println("Error generating Mapper:", err)
+							continue
 						}
-						if strings.Contains(comment.Text, "@NoArgsConstructor") {
-							var isDefault bool
-							log.Printf("Found @NoArgsConstructor in %s\n", typeSpec.Name.Name)
 
-							if strings.Contains(comment.Text, ".Default") {
-								isDefault = true
-								log.Println("Found Default in @NoArgsConstructor")
-							}
+						targets := args.List("to")
+						if len(targets) == 0 {
+							log.Printf("Error generating Mapper: %s has no target, use @Mapper(to=Target)", typeSpec.Name.Name)
+							continue
+						}
 
-							result, err = generate.NoArgsConstructor(typeSpec.Name.Name, isDefault)
-							if err != nil {
-								log.Println("Error generating NoArgsConstructor:", err)
+						for _, target := range targets {
+							targetStruct, exists := pkg.structs[target]
+							if !exists {
+								log.Printf("Error generating Mapper: struct %s not found in package of %s", target, typeSpec.Name.Name)
 								continue
 							}
 
-							fileContent += result
-						}
-						if strings.Contains(comment.Text, "@Builder") {
-							log.Printf("Found @Builder in %s\n", typeSpec.Name.Name)
-							result, err = generate.Builder(typeSpec.Name.Name, structType.Fields.List)
+							mapped, err := generate.Mapper(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, target, pkg.typeParams[target], targetStruct.Fields.List, pkg.info, cfg.Templates)
 							if err != nil {
-								log.Println("Error generating Builder:", err)
+								log.Println("Error generating Mapper:", err)
 								continue
 							}
 
-							requireReflectPkg = true
-							fileContent += result
+							result += mapped
 						}
-						if strings.Contains(comment.Text, "@ToString") {
-							log.Printf("Found @ToString in %s", typeSpec.Name.Name)
-							result, err = generate.ToString(typeSpec.Name.Name, structType.Fields.List)
-							if err != nil {
-								log.Println("Error generating ToString:", err)
-								continue
-							}
-
-							fileContent += result
+					case "Delegate":
+						// 다른 어노테이션이 생성한 메서드를 위임하지 않도록 모든 코드를 생성한 뒤 이 위치에 추가합니다.
+						delegateAt = len(typeContent)
+					case "With":
+						log.Printf("Found @With in %s", typeSpec.Name.Name)
+						var withImports []string
+						result, withImports, err = generate.With(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, typesWith(pkg.typeAnnotations, "Clone"), pkg.types, importAliases(importPkgs), pkg.info, cfg.Templates)
+						if err != nil {
+							log.Println("Error generating With:", err)
+							continue
 						}
-						if strings.Contains(comment.Text, "@Equals") {
-							log.Printf("Found @Equals in %s", typeSpec.Name.Name)
-							result, err = generate.Equals(typeSpec.Name.Name)
-							if err != nil {
-								log.Println("Error generating Equals:", err)
-								continue
-							}
 
-							requireReflectPkg = true
-							fileContent += result
+						for _, importPath := range withImports {
+							importPkgs = appendImport(importPkgs, filepkg.ImportPackage{Path: importPath, Name: pkg.importName(importPath)})
 						}
-						if strings.Contains(comment.Text, "@Getter") {
-							log.Printf("Found @Getter in %s", typeSpec.Name.Name)
-							result, err = generate.Getter(typeSpec.Name.Name, structType.Fields.List)
-							if err != nil {
-								log.Println("Error generating Getter:", err)
-								continue
-							}
-
-							fileContent += result
+					case "Options":
+						log.Printf("Found @Options in %s", typeSpec.Name.Name)
+						result, err = generate.Options(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, args, pkg.otherOptionFunctions(typeSpec.Name.Name, cfg), cfg.Templates)
+						if err != nil {
+							log.Println("Error generating Options:", err)
+							continue
 						}
-						if strings.Contains(comment.Text, "@Setter") {
-							log.Printf("Found @Setter in %s", typeSpec.Name.Name)
-							result, err = generate.Setter(typeSpec.Name.Name, structType.Fields.List)
-							if err != nil {
-								log.Println("Error generating Setter:", err)
-								continue
-							}
+					}
 
-							fileContent += result
-						}
+					typeContent += result
+				}
+
+				// 설정 파일에서 정의한 어노테이션은 이름으로 플러그인을 찾아 생성합니다.
+				for _, annotation := range sortedKeys(found) {
+					plugin, ok := cfg.Plugins[annotation]
+					if !ok {
+						continue
+					}
+
+					log.Printf("Found @%s in %s", annotation, typeSpec.Name.Name)
+					result, err := plugin.Generate(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, found[annotation], pkg.info)
+					if err != nil {
+						log.Printf("Error generating %s: %v", annotation, err)
+						continue
 					}
+
+					for _, importPath := range plugin.Imports {
+						importPkgs = appendImport(importPkgs, filepkg.ImportPackage{Path: importPath, Name: pkg.importName(importPath)})
+					}
+					typeContent += result
 				}
-			}
 
-			return true
-		})
+				if delegateAt >= 0 {
+					log.Printf("Found @Delegate in %s", typeSpec.Name.Name)
+					declared := generatedMethods(typeSpec.Name.Name, typeContent)
+					for method := range pkg.methods[typeSpec.Name.Name] {
+						declared[method] = true
+					}
 
-		if fileContent != "" {
-			newFileName := strings.TrimSuffix(info.Name(), ".go") + "_gombok.go"
-			newFilePath := filepath.Join(filepath.Dir(path), newFileName)
-			if requireReflectPkg {
-				importPkgs = append(importPkgs, filepkg.ImportPackage{
-					Path: "reflect",
-				})
-			}
+					result, delegateImports, err := generate.Delegate(typeSpec.Name.Name, typeSpec.TypeParams, structType.Fields.List, found["Delegate"], pkg.types, importAliases(importPkgs), declared, cfg.Templates)
+					if err != nil {
+						log.Println("Error generating Delegate:", err)
+					} else {
+						for _, importPath := range delegateImports {
+							importPkgs = appendImport(importPkgs, filepkg.ImportPackage{Path: importPath, Name: pkg.importName(importPath)})
+						}
+						typeContent = typeContent[:delegateAt] + result + typeContent[delegateAt:]
+					}
+				}
 
-			if err = filepkg.WriteFile(file.Name.Name, importPkgs, fileContent, newFilePath); err != nil {
-				log.Println("Error writing file:", err)
+				if typeContent != "" {
+					types = append(types, typeCode{name: typeSpec.Name.Name, content: typeContent})
+				}
 			}
 		}
 
-		return nil
+		return true
 	})
 
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("%s: %s", path, strings.Join(invalid, "; "))
+	}
+
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	return &fileCode{packageName: file.Name.Name, imports: importPkgs, types: types}, nil
+}
+
+// importAliases 는 소스 파일에서 별칭으로 import 한 패키지의 import 경로별 별칭을 반환합니다.
+// _ 와 . 으로 import 한 패키지는 별칭으로 참조할 수 없으므로 포함하지 않습니다.
+func importAliases(importPkgs []filepkg.ImportPackage) map[string]string {
+	aliases := make(map[string]string)
+	for _, importPkg := range importPkgs {
+		if importPkg.Alias != "" && importPkg.Alias != "_" && importPkg.Alias != "." {
+			aliases[importPkg.Path] = importPkg.Alias
+		}
+	}
+
+	return aliases
+}
+
+// generatedMethods 는 생성된 코드에서 typeName 을 receiver 로 하는 메서드 이름을 모읍니다.
+// 바꾼 템플릿과 플러그인이 생성한 메서드도 포함하기 위해 생성된 코드를 파싱합니다.
+func generatedMethods(typeName string, content string) map[string]bool {
+	methods := make(map[string]bool)
+
+	file, err := goparser.ParseFile(token.NewFileSet(), "", "package p\n"+content, goparser.SkipObjectResolution)
 	if err != nil {
-		fmt.Println("Error processing files:", err)
+		return methods
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if ok && funcDecl.Recv != nil && len(funcDecl.Recv.List) > 0 && receiverTypeName(funcDecl.Recv.List[0].Type) == typeName {
+			methods[funcDecl.Name.Name] = true
+		}
+	}
+
+	return methods
+}
+
+// sortedKeys 는 어노테이션 이름을 정렬하여 반환합니다.
+func sortedKeys(found map[string]generate.Arguments) []string {
+	keys := make([]string, 0, len(found))
+	for key := range found {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// appendImport 는 중복을 방지하기 위해 이미 importPkgs에 포함되어 있지 않은 경우에만 패키지를 추가합니다.
+func appendImport(importPkgs []filepkg.ImportPackage, importPkg filepkg.ImportPackage) []filepkg.ImportPackage {
+	for _, pkg := range importPkgs {
+		if pkg.Path == importPkg.Path {
+			return importPkgs
+		}
 	}
 
+	return append(importPkgs, importPkg)
 }
@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/YangTaeyoung/gombok/config"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// scope 는 코드를 생성할 범위입니다.
+type scope struct {
+	// root 는 작업 디렉토리로, 상대 경로와 filter 의 기준이 됩니다.
+	root string
+	// patterns 는 packages.Load 에 전달할 패키지 패턴입니다.
+	patterns []string
+	// files 가 nil 이 아니라면 해당 소스 파일의 코드만 생성합니다.
+	files  map[string]bool
+	filter Filter
+	// onlyType 이 지정되면 해당 타입의 코드만 생성합니다.
+	onlyType string
+	// pkgName 이 지정되면 이름이 같은 패키지의 코드만 생성합니다. 같은 디렉토리의 _test 패키지를 구분할 때 사용합니다.
+	pkgName string
+	// configs 는 디렉토리별 .gombok.yaml 설정을 읽습니다.
+	configs *config.Loader
+}
+
+// newScope 는 명령줄 인자로 코드를 생성할 범위를 만듭니다.
+// .go 로 끝나는 인자는 소스 파일, 나머지는 ./internal/... 과 같은 패키지 패턴이며, 인자가 없다면 ./... 입니다.
+// go build 와 같이 소스 파일과 패키지 패턴을 함께 지정할 수 없습니다.
+func newScope(opts Options) (scope, error) {
+	root, err := os.Getwd()
+	if err != nil {
+		return scope{}, fmt.Errorf("getting current directory: %w", err)
+	}
+
+	s := scope{root: root, filter: opts.Filter, patterns: make([]string, 0), configs: config.NewLoader()}
+	for _, arg := range opts.Patterns {
+		if !strings.HasSuffix(arg, ".go") {
+			s.patterns = append(s.patterns, arg)
+			continue
+		}
+
+		path, err := filepath.Abs(arg)
+		if err != nil {
+			return scope{}, fmt.Errorf("resolving %s: %w", arg, err)
+		}
+
+		if s.files == nil {
+			s.files = make(map[string]bool)
+		}
+		s.files[path] = true
+		s.patterns = append(s.patterns, "file="+path)
+	}
+
+	if s.files != nil && len(s.files) != len(s.patterns) {
+		return scope{}, fmt.Errorf("cannot mix .go files and package patterns")
+	}
+
+	if len(s.patterns) == 0 {
+		s.patterns = append(s.patterns, "./...")
+	}
+
+	return s, nil
+}
+
+// loadConfig 는 패키지 디렉토리에 적용되는 설정을 읽습니다.
+func (s scope) loadConfig(loadedPkg *packages.Package) (*config.Config, error) {
+	if len(loadedPkg.GoFiles) == 0 {
+		return config.Default(), nil
+	}
+
+	cfg, err := s.configs.Load(filepath.Dir(loadedPkg.GoFiles[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	// 합성 어노테이션은 인자를 받지 않으므로 기본 인자를 지정할 수 없습니다.
+	for annotation := range cfg.Annotations {
+		if _, isComposite := compositeAnnotations[annotation]; !isKnown(annotation, cfg.Plugins) || (isComposite && annotation != "HashCode") {
+			return nil, fmt.Errorf("%s: cannot set default arguments of @%s", config.FileName, annotation)
+		}
+	}
+
+	for annotation := range cfg.Plugins {
+		if isAnnotation(annotation) {
+			return nil, fmt.Errorf("%s: plugin @%s conflicts with the built-in annotation", config.FileName, annotation)
+		}
+	}
+
+	return cfg, nil
+}
+
+// includes 는 소스 파일이 코드를 생성할 범위에 포함되는지 확인합니다.
+func (s scope) includes(path string, cfg *config.Config) bool {
+	if s.files != nil && !s.files[path] {
+		return false
+	}
+
+	return !skipPath(s.root, path, s.filter) && !excludedByConfig(path, cfg)
+}
+
+// includesGenerated 는 생성된 파일이 삭제할 파일을 찾는 범위에 포함되는지 확인합니다.
+// 패키지 단위로 생성할 때는 소스 파일을 지정하더라도 패키지 전체를 생성하므로 패키지의 모든 생성된 파일이 포함됩니다.
+func (s scope) includesGenerated(path string, cfg *config.Config) bool {
+	if s.files != nil && cfg.Output != config.OutputPackage {
+		for source := range s.files {
+			if generatedPath(source, cfg.Suffix) == path {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return !skipPath(s.root, path, s.filter) && !excludedByConfig(path, cfg)
+}
+
+// skipPath 는 filter 에 따라 파일을 건너뛰어야 하는지 확인합니다.
+func skipPath(root string, path string, filter Filter) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return true
+	}
+
+	return filter.skip(rel)
+}
+
+// excludedByConfig 는 파일이 설정 파일의 exclude 패턴과 일치하는지 확인합니다.
+// 패턴은 설정 파일이 있는 디렉토리 기준의 상대 경로와 비교합니다.
+func excludedByConfig(path string, cfg *config.Config) bool {
+	for _, exclude := range cfg.Excludes {
+		rel, err := filepath.Rel(exclude.Dir, path)
+		if err != nil {
+			continue
+		}
+
+		for _, pattern := range exclude.Patterns {
+			if matchGlob(pattern, filepath.ToSlash(rel)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
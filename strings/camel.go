@@ -9,6 +9,13 @@ func LowerCamel(str string) string {
 	return strings.ToLower(str[:1]) + str[1:]
 }
 
+func UpperCamel(str string) string {
+	if len(str) == 0 {
+		return ""
+	}
+	return strings.ToUpper(str[:1]) + str[1:]
+}
+
 func ReceiverName(structName string) string {
 	if len(structName) == 0 {
 		return ""